@@ -0,0 +1,270 @@
+package avl
+
+import (
+	"github.com/iNamik/go_bst"
+	"github.com/iNamik/go_bst/finder"
+	"github.com/iNamik/go_bst/visitor"
+	"github.com/iNamik/go_bst/walker"
+	"github.com/iNamik/go_cmp"
+)
+
+import (
+	"sync"
+)
+
+/**********************************************************************
+ ** Types & Interfaces
+ **********************************************************************/
+
+// T
+type T interface {
+	bst.T
+	finder.I
+	visitor.I
+	walker.I
+	bst.I_Size
+	finder.I_Min
+	finder.I_Max
+}
+
+// node
+type node struct {
+	key    interface{}
+	value  interface{}
+	left   *node
+	right  *node
+	height int // Height of the subtree rooted at this node, leaf == 1
+}
+
+// tree
+type tree struct {
+	mutex *sync.Mutex
+	root  *node
+	fcmp  cmp.F
+	size  int
+}
+
+/**********************************************************************
+ ** Public Functions
+ **********************************************************************/
+
+// New
+func New(fcmp cmp.F) T {
+	return &tree{mutex: &sync.Mutex{}, root: nil, fcmp: fcmp, size: 0}
+}
+
+// tree:Empty
+func (t *tree) Empty() bool {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return t.size == 0
+}
+
+// tree:Size
+func (t *tree) Size() int {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return t.size
+}
+
+// tree:ReplaceOrInsert
+func (t *tree) ReplaceOrInsert(key interface{}, value interface{}) bool {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	var replaced bool
+	t.root, replaced = replaceOrInsert(t.root, key, value, t.fcmp)
+	if !replaced {
+		t.size++
+	}
+	return replaced
+}
+
+// tree::Get
+func (t *tree) Get(key interface{}) (interface{}, bool) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	h := get(t.root, key, t.fcmp)
+	if h != nil {
+		return h.value, true
+	}
+	return nil, false
+}
+
+// tree::Remove
+func (t *tree) Remove(key interface{}) bool {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	var removed bool
+	t.root, removed = remove(t.root, key, t.fcmp)
+	if removed {
+		t.size--
+	}
+	return removed
+}
+
+/**********************************************************************
+ ** Private Functions
+ **********************************************************************/
+
+// replaceOrInsert returns true if key was replaced, false if it was inserted into the tree
+func replaceOrInsert(h *node, key interface{}, value interface{}, fcmp cmp.F) (*node, bool) {
+	if h == nil {
+		return &node{key: key, value: value, height: 1}, false
+	}
+	replaced := true
+	switch fcmp(key, h.key) {
+	case cmp.LT:
+		h.left, replaced = replaceOrInsert(h.left, key, value, fcmp)
+	case cmp.GT:
+		h.right, replaced = replaceOrInsert(h.right, key, value, fcmp)
+	default:
+		h.value = value
+	}
+	// Only an insert can change the shape of the tree
+	if !replaced {
+		h = balance(h)
+	}
+	return h, replaced
+}
+
+// get
+func get(h *node, key interface{}, fcmp cmp.F) *node {
+	for h != nil {
+		switch fcmp(key, h.key) {
+		case cmp.LT:
+			h = h.left
+		case cmp.GT:
+			h = h.right
+		default:
+			return h
+		}
+	}
+	return nil
+}
+
+// remove
+func remove(h *node, key interface{}, fcmp cmp.F) (*node, bool) {
+	removed := false
+	if h != nil {
+		switch fcmp(key, h.key) {
+		case cmp.LT:
+			h.left, removed = remove(h.left, key, fcmp)
+		case cmp.GT:
+			h.right, removed = remove(h.right, key, fcmp)
+		default:
+			return removeNode(h), true
+		}
+		if removed {
+			h = balance(h)
+		}
+	}
+	return h, removed
+}
+
+// removeNode removes h from its subtree, returning the new (balanced) subtree root.
+// When h has two children, it is replaced by a neighbor taken from its
+// taller subtree, which keeps the subtree as balanced as possible.
+func removeNode(h *node) *node {
+	if h.left == nil {
+		return h.right
+	}
+	if h.right == nil {
+		return h.left
+	}
+	var n *node // Replacement node
+	if height(h.left) > height(h.right) {
+		h.left, n = removeMax(h.left)
+	} else {
+		h.right, n = removeMin(h.right)
+	}
+	n.left, n.right = h.left, h.right
+	return balance(n)
+}
+
+// removeMin detaches min(h), returning the new (balanced) subtree root and the detached node
+func removeMin(h *node) (*node, *node) {
+	if h.left == nil {
+		return h.right, h
+	}
+	var n *node
+	h.left, n = removeMin(h.left)
+	return balance(h), n
+}
+
+// removeMax detaches max(h), returning the new (balanced) subtree root and the detached node
+func removeMax(h *node) (*node, *node) {
+	if h.right == nil {
+		return h.left, h
+	}
+	var n *node
+	h.right, n = removeMax(h.right)
+	return balance(h), n
+}
+
+/**********************************************************************
+ ** Balancing Functions
+ **********************************************************************/
+
+// height returns the height of h, with nil having a height of 0
+func height(h *node) int {
+	if h == nil {
+		return 0
+	}
+	return h.height
+}
+
+// fixHeight recomputes the height of h from its children
+func fixHeight(h *node) {
+	hl, hr := height(h.left), height(h.right)
+	if hl > hr {
+		h.height = hl + 1
+	} else {
+		h.height = hr + 1
+	}
+}
+
+// balanceFactor returns height(left) - height(right)
+func balanceFactor(h *node) int {
+	return height(h.left) - height(h.right)
+}
+
+// rotateLeft
+func rotateLeft(h *node) *node {
+	x := h.right
+	h.right = x.left
+	x.left = h
+	fixHeight(h)
+	fixHeight(x)
+	return x
+}
+
+// rotateRight
+func rotateRight(h *node) *node {
+	x := h.left
+	h.left = x.right
+	x.right = h
+	fixHeight(h)
+	fixHeight(x)
+	return x
+}
+
+// balance restores the AVL property at h, assuming both of its
+// subtrees are balanced and differ in height by no more than 2.
+func balance(h *node) *node {
+	fixHeight(h)
+	switch bf := balanceFactor(h); {
+	// Left heavy
+	case bf > 1:
+		if balanceFactor(h.left) < 0 {
+			h.left = rotateLeft(h.left)
+		}
+		return rotateRight(h)
+	// Right heavy
+	case bf < -1:
+		if balanceFactor(h.right) > 0 {
+			h.right = rotateRight(h.right)
+		}
+		return rotateLeft(h)
+	}
+	return h
+}
@@ -0,0 +1,115 @@
+package avl
+
+import (
+	"testing"
+)
+
+import (
+	"github.com/iNamik/go_cmp"
+)
+
+/**********************************************************************
+ ** Test Functions
+ **********************************************************************/
+
+// Test_Empty
+func Test_Empty(t *testing.T) {
+	r := New(cmp.F_int)
+	assertEmpty(r, true, t)
+	assertSize(r, 0, t)
+	assertReplaceOrInsert(r, key1, key1, false, t)
+	assertEmpty(r, false, t)
+	assertSize(r, 1, t)
+}
+
+// Test_Replace
+func Test_Replace(t *testing.T) {
+	r := New(cmp.F_int)
+	assertReplaceOrInsert(r, key1, key1, false, t)
+	assertReplaceOrInsert(r, key1, key2, true, t)
+	assertGet(r, key1, key2, true, t)
+	assertSize(r, 1, t)
+}
+
+// Test_Get_NotFound
+func Test_Get_NotFound(t *testing.T) {
+	r := New(cmp.F_int)
+	assertGet(r, key1, nil, false, t)
+	assertReplaceOrInsert(r, key1, key1, false, t)
+	assertGet(r, key2, nil, false, t)
+}
+
+// Test_Remove
+func Test_Remove(t *testing.T) {
+	r := New(cmp.F_int)
+	assertRemove(r, key1, false, t)
+	assertReplaceOrInsert(r, key2, key2, false, t)
+	assertRemove(r, key1, false, t)
+	assertRemove(r, key3, false, t)
+	assertRemove(r, key2, true, t)
+	assertEmpty(r, true, t)
+}
+
+// Test_Min_Max
+func Test_Min_Max(t *testing.T) {
+	r := New(cmp.F_int)
+	assertKVF(-1, -1, false, r.Min, t)
+	assertKVF(-1, -1, false, r.Max, t)
+	r = randomTree(1000)
+	assertKVF(0, 0, true, r.Min, t)
+	assertKVF(999, 999, true, r.Max, t)
+}
+
+// Test_Sorted_Insert confirms that inserting keys in order does not
+// degenerate the tree into a list
+func Test_Sorted_Insert(t *testing.T) {
+	const SIZE = 1 << 16
+	r := New(cmp.F_int)
+	for i := 0; i < SIZE; i++ {
+		assertReplaceOrInsert(r, i, i, false, t)
+	}
+	assertSize(r, SIZE, t)
+	assertAVL(r, t)
+	// A perfectly balanced tree of 2^16 nodes has height 17
+	if h := r.(*tree).root.height; h != 17 {
+		t.Fatalf("tree has height %d instead of %d", h, 17)
+	}
+}
+
+// Test_Size_Large_InsertRemove
+func Test_Size_Large_InsertRemove(t *testing.T) {
+	const SIZE = 1000
+	r := randomTree(SIZE * 2)
+	for _, i_ := range random.Perm(SIZE) {
+		i := i_ + i_
+		assertRemove(r, i, true, t)
+		assertGet(r, i, nil, false, t)
+	}
+	assertSize(r, SIZE, t)
+	assertAVL(r, t)
+}
+
+// Test_Tree_Large_RandomInsertRemove
+func Test_Tree_Large_RandomInsertRemove(t *testing.T) {
+	const SIZE = 1000
+	const COUNT = 100000
+	var array [SIZE]bool
+	r := New(cmp.F_int)
+	size := 0
+	for i := 0; i < COUNT; i++ {
+		n := random.Intn(SIZE)
+		if array[n] == false {
+			assertReplaceOrInsert(r, n, i, false, t)
+			size++
+		} else {
+			assertRemove(r, n, true, t)
+			size--
+		}
+		array[n] = !array[n]
+		if i%1000 == 0 {
+			assertAVL(r, t)
+		}
+	}
+	assertSize(r, size, t)
+	assertAVL(r, t)
+}
@@ -0,0 +1,90 @@
+/*
+
+Package avl provides a self-balancing implementation of an
+extensible Binary Search Tree as defined in the go_bst
+package and sub-packages.
+
+The tree is kept balanced using the AVL algorithm, so its height
+never exceeds ~1.44*log2(n), regardless of the order in which keys
+are inserted.  It exposes the same API as the simple package and can
+be used anywhere simple.New is used today.
+
+
+Standard BST Methods
+--------------------
+
+All of the standard BST methods required to satisfy the
+bst.T interface have been implemented:
+
+ * Empty
+ * ReplaceOrInsert
+ * Get
+ * Remove
+
+
+Extensible BST Methods
+----------------------
+
+All of the extensible interfaces have been implemented:
+
+ * Find  (see finder.T)
+ * Visit (see visitor.T)
+ * Walk  (see walker.T)
+
+
+Additional BST Methods
+----------------------
+
+The following additional BST methods have been implemented:
+
+ * Size (see bst.I_Size)
+ * Min  (see finder.I_Min)
+ * Max  (see finder.I_Max)
+
+
+Balancing
+---------
+
+Each node stores the height of its subtree.  The tree is
+rebalanced, using single or double rotations, on the way back
+up from the following operations:
+
+ * ReplaceOrInsert (when a key is inserted)
+ * Remove          (when a key is removed)
+ * Visit           (when the INSERT or REMOVE action is taken)
+
+When removing a node that has two children, the node is replaced
+by its neighbor from the taller of its two subtrees.
+
+
+Effeciency
+----------
+
+Instead of storing parent and sibling information on each node,
+this implementation uses recursion to accomplish various tree
+navigation functions.  Specifically, the following functions
+use recursion:
+
+ * ReplaceOrInsert
+ * Remove
+ * Visit
+ * Walk
+
+Since the tree is balanced, the depth of the recursion is
+bounded by O(log n).
+
+
+License
+-------
+
+This package is released under the MIT License.
+See included file 'LICENSE' for more details.
+
+
+Contributors
+------------
+
+David Farell <DavidPFarrell@yahoo.com>
+
+*/
+package avl
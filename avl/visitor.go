@@ -0,0 +1,62 @@
+package avl
+
+import "fmt"
+
+import (
+	"github.com/iNamik/go_bst/visitor"
+	"github.com/iNamik/go_cmp"
+)
+
+// tree::Visit
+func (t *tree) Visit(key interface{}, f visitor.F) (value interface{}, result visitor.Result) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.root, value, result = visit(t.root, key, t.fcmp, f)
+	if result == visitor.INSERTED {
+		t.size++
+	} else if result == visitor.REMOVED {
+		t.size--
+	}
+	return value, result
+}
+
+// visit
+func visit(h *node, key interface{}, fcmp cmp.F, f visitor.F) (_ *node, value interface{}, result visitor.Result) {
+	if h == nil {
+		var action visitor.Action
+		value, action = f(nil, false)
+		switch action {
+		case visitor.INSERT:
+			return &node{key: key, value: value, height: 1}, value, visitor.INSERTED
+		case visitor.GET:
+			return nil, nil, visitor.NOT_FOUND
+		default:
+			panic(fmt.Sprintf("illegal action '%s' when visiting non-found key", action))
+		}
+	}
+	switch fcmp(key, h.key) {
+	case cmp.LT:
+		h.left, value, result = visit(h.left, key, fcmp, f)
+	case cmp.GT:
+		h.right, value, result = visit(h.right, key, fcmp, f)
+	default:
+		var action visitor.Action
+		value, action = f(h.value, true)
+		switch action {
+		case visitor.GET:
+			return h, h.value, visitor.FOUND
+		case visitor.REPLACE:
+			h.value = value
+			return h, value, visitor.REPLACED
+		case visitor.REMOVE:
+			return removeNode(h), h.value, visitor.REMOVED
+		default:
+			panic(fmt.Sprintf("illegal action '%s' when visiting found key", action))
+		}
+	}
+	// Only an insert or a remove can change the shape of the tree
+	if result == visitor.INSERTED || result == visitor.REMOVED {
+		h = balance(h)
+	}
+	return h, value, result
+}
@@ -38,6 +38,61 @@ in sequential order (prev, next).
 Provides a reference implementation of an Extensible BST that
 implements all of the above-declared methods.
 
+* bst/typed
+
+Provides a type-safe facade, using Go generics, over any
+implementation of the above-declared methods.
+
+* bst/gsimple
+
+Provides a generics-native port of the simple reference
+implementation, storing typed keys and values directly.
+
+* bst/avl
+
+Provides a self-balancing (AVL) implementation of an Extensible
+BST that implements all of the above-declared methods.
+
+* bst/redblack
+
+Provides a self-balancing (red-black) implementation of an Extensible
+BST that implements all of the above-declared methods.
+
+* bst/treap
+
+Provides a randomized (treap) implementation of an Extensible
+BST that implements all of the above-declared methods.
+
+* bst/splay
+
+Provides a self-adjusting (splay) implementation of an Extensible
+BST that implements all of the above-declared methods.
+
+* bst/linked
+
+Provides an implementation of an Extensible BST whose nodes link to
+their parent and in-order neighbors, so that no method uses recursion.
+
+* bst/persistent
+
+Provides a persistent (immutable) implementation, where each change
+returns a new version of the tree, sharing unchanged nodes.
+
+* bst/set
+
+Provides an ordered set, with set algebra, stored in any
+implementation of bst.T and walker.I.
+
+* bst/multimap
+
+Provides an ordered multimap, holding any number of values per key,
+stored in any implementation of bst.T, visitor.I and walker.I.
+
+* bst/bsttest
+
+Provides a reusable conformance test suite for any implementation
+of the above-declared methods.
+
 
 License
 -------
@@ -95,3 +150,23 @@ type I_Get interface {
 type I_Remove interface {
 	Remove(key interface{}) (removed bool)
 }
+
+// I_Rank returns the number of keys in the tree that are less
+// than the specified key, which need not be in the tree.
+type I_Rank interface {
+	Rank(key interface{}) (rank int)
+}
+
+// I_Select retrieves the key with the specified rank (the i'th
+// smallest key, counting from 0), returning key,value,true if
+// 0 <= i < size and <undefined>,<undefined>,false otherwise.
+type I_Select interface {
+	Select(i int) (key interface{}, value interface{}, found bool)
+}
+
+// I_Validate checks the structural invariants of the tree,
+// returning a descriptive error for the first violation found,
+// or nil if the tree is valid.
+type I_Validate interface {
+	Validate() error
+}
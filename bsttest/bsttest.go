@@ -0,0 +1,225 @@
+package bsttest
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+import (
+	"github.com/iNamik/go_bst"
+	"github.com/iNamik/go_bst/finder"
+	"github.com/iNamik/go_bst/visitor"
+	"github.com/iNamik/go_bst/walker"
+	"github.com/iNamik/go_cmp"
+)
+
+/**********************************************************************
+ ** Types & Interfaces
+ **********************************************************************/
+
+// Finder is a tree that supports Find
+type Finder interface {
+	bst.T
+	finder.I
+}
+
+// Visitor is a tree that supports Visit
+type Visitor interface {
+	bst.T
+	visitor.I
+}
+
+// Walker is a tree that supports Walk
+type Walker interface {
+	bst.T
+	walker.I
+}
+
+// F_T creates a new, empty tree whose keys are ordered by fcmp
+type F_T func(fcmp cmp.F) bst.T
+
+// F_Finder creates a new, empty tree whose keys are ordered by fcmp
+type F_Finder func(fcmp cmp.F) Finder
+
+// F_Visitor creates a new, empty tree whose keys are ordered by fcmp
+type F_Visitor func(fcmp cmp.F) Visitor
+
+// F_Walker creates a new, empty tree whose keys are ordered by fcmp
+type F_Walker func(fcmp cmp.F) Walker
+
+/**********************************************************************
+ ** Settings
+ **********************************************************************/
+
+// SIZE is the number of distinct keys used by the randomized tests.
+// Keys are the ints [0, SIZE).
+const SIZE = 1000
+
+// COUNT is the number of operations performed by the randomized tests
+const COUNT = 20000
+
+// CHECK is how often (in operations) the randomized tests compare
+// the entire tree against the reference map
+const CHECK = 1000
+
+// SEED is the seed used by the randomized tests, so that any
+// failure is reproducible
+const SEED = 1
+
+/**********************************************************************
+ ** Reference Model
+ **********************************************************************/
+
+// model is the reference map that trees are checked against
+type model map[int]interface{}
+
+// model::keys returns the keys of the model in ascending order
+func (m model) keys() []int {
+	keys := make([]int, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Ints(keys)
+	return keys
+}
+
+// newRand
+func newRand() *rand.Rand {
+	return rand.New(rand.NewSource(SEED))
+}
+
+// fill inserts the keys [0, n) in random order, with each key mapped
+// to key*scale, returning the matching model
+func fill(tree bst.T, n int, scale int, r *rand.Rand) model {
+	m := model{}
+	for _, i := range r.Perm(n) {
+		k := i * scale
+		tree.ReplaceOrInsert(k, k)
+		m[k] = k
+	}
+	return m
+}
+
+/**********************************************************************
+ ** Checks
+ **********************************************************************/
+
+// checkTree compares every key in [-1, SIZE*2] against the model, along
+// with any of Validate, Size, Min, Max, Rank and Select that the tree implements
+func checkTree(t *testing.T, tree bst.T, m model) {
+	if v, ok := tree.(bst.I_Validate); ok {
+		if err := v.Validate(); err != nil {
+			t.Fatalf("Validate() returned '%v'", err)
+		}
+	}
+	for k := -1; k <= SIZE*2; k++ {
+		checkGet(t, tree, k, m)
+	}
+	if empty := tree.Empty(); empty != (len(m) == 0) {
+		t.Fatalf("Empty() returned %v instead of %v", empty, len(m) == 0)
+	}
+	if s, ok := tree.(bst.I_Size); ok {
+		if size := s.Size(); size != len(m) {
+			t.Fatalf("Size() returned %d instead of %d", size, len(m))
+		}
+	}
+	keys := m.keys()
+	if i, ok := tree.(finder.I_Min); ok {
+		if len(keys) == 0 {
+			checkKVF(t, "Min()", i.Min, 0, nil, false)
+		} else {
+			checkKVF(t, "Min()", i.Min, keys[0], m[keys[0]], true)
+		}
+	}
+	if i, ok := tree.(finder.I_Max); ok {
+		if len(keys) == 0 {
+			checkKVF(t, "Max()", i.Max, 0, nil, false)
+		} else {
+			checkKVF(t, "Max()", i.Max, keys[len(keys)-1], m[keys[len(keys)-1]], true)
+		}
+	}
+	if i, ok := tree.(bst.I_Rank); ok {
+		for k := -1; k <= SIZE*2; k++ {
+			if rank, rank_ := i.Rank(k), sort.SearchInts(keys, k); rank != rank_ {
+				t.Fatalf("Rank(%d) returned %d instead of %d", k, rank, rank_)
+			}
+		}
+	}
+	if i, ok := tree.(bst.I_Select); ok {
+		for j := -1; j <= len(keys); j++ {
+			name := fmt.Sprintf("Select(%d)", j)
+			f := func() (interface{}, interface{}, bool) { return i.Select(j) }
+			if j < 0 || j == len(keys) {
+				checkKVF(t, name, f, 0, nil, false)
+			} else {
+				checkKVF(t, name, f, keys[j], m[keys[j]], true)
+			}
+		}
+	}
+}
+
+// checkGet
+func checkGet(t *testing.T, tree bst.T, key int, m model) {
+	value, found := tree.Get(key)
+	value_, found_ := m[key]
+	if found != found_ {
+		t.Fatalf("Get(%d) returned found %v instead of %v", key, found, found_)
+	}
+	if found && value != value_ {
+		t.Fatalf("Get(%d) returned value '%v' instead of '%v'", key, value, value_)
+	}
+}
+
+// checkKVF calls a func of type func()(key,value,found) and confirms the results
+func checkKVF(t *testing.T, name string, f func() (interface{}, interface{}, bool), key int, value interface{}, found bool) {
+	k_, v_, found_ := f()
+	if found_ != found {
+		t.Fatalf("%s returned found %v instead of %v", name, found_, found)
+	}
+	if found {
+		if k, ok := k_.(int); !ok || k != key {
+			t.Fatalf("%s returned key '%v' instead of '%d'", name, k_, key)
+		}
+		if v_ != value {
+			t.Fatalf("%s returned value '%v' instead of '%v'", name, v_, value)
+		}
+	}
+}
+
+// checkPanic confirms that f panics
+func checkPanic(t *testing.T, name string, f func()) {
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("%s did not panic", name)
+		}
+	}()
+	f()
+}
+
+// checkBounds compares LowerBound and UpperBound, as implemented by
+// flb and fub, against the model for every key in [-1, SIZE*2]
+func checkBounds(t *testing.T, m model, flb func(interface{}) (interface{}, interface{}, bool), fub func(interface{}) (interface{}, interface{}, bool)) {
+	keys := m.keys()
+	for k := -1; k <= SIZE*2; k++ {
+		// Index of the first key >= k
+		i := sort.SearchInts(keys, k)
+		name := fmt.Sprintf("UpperBound(%d)", k)
+		if i < len(keys) {
+			checkKVF(t, name, func() (interface{}, interface{}, bool) { return fub(k) }, keys[i], m[keys[i]], true)
+		} else {
+			checkKVF(t, name, func() (interface{}, interface{}, bool) { return fub(k) }, 0, nil, false)
+		}
+		// Index of the last key <= k
+		if i == len(keys) || keys[i] != k {
+			i--
+		}
+		name = fmt.Sprintf("LowerBound(%d)", k)
+		if i >= 0 {
+			checkKVF(t, name, func() (interface{}, interface{}, bool) { return flb(k) }, keys[i], m[keys[i]], true)
+		} else {
+			checkKVF(t, name, func() (interface{}, interface{}, bool) { return flb(k) }, 0, nil, false)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+/*
+
+Package bsttest provides a reusable conformance test suite for
+implementations of the go_bst interfaces.
+
+Each Run function exercises the full contract of one interface
+against trees created by a caller-supplied constructor:
+
+ * RunT       (see bst.T, plus bst.I_Size, bst.I_Rank, bst.I_Select,
+               finder.I_Min and finder.I_Max, if implemented)
+ * RunFinder  (see finder.I, and the finder helper functions)
+ * RunVisitor (see visitor.I, and the visitor helper functions)
+ * RunWalker  (see walker.I, and the walker helper functions)
+
+The suite uses int keys, so the constructor is passed the cmp.F
+function to order them with.
+
+Whenever the suite checks the contents of a tree, it also calls
+Validate if the tree implements bst.I_Validate.
+
+
+Example
+-------
+
+Below is how the simple package runs the suite against itself:
+
+	// Test_BSTTest_T
+	func Test_BSTTest_T(t *testing.T) {
+		bsttest.RunT(t, func(fcmp cmp.F) bst.T { return New(fcmp) })
+	}
+
+	// Test_BSTTest_Walker
+	func Test_BSTTest_Walker(t *testing.T) {
+		bsttest.RunWalker(t, func(fcmp cmp.F) bsttest.Walker { return New(fcmp) })
+	}
+
+
+Randomized Tests
+----------------
+
+In addition to fixed scenarios, the suite performs randomized
+sequences of inserts, removes and visits, checking the tree
+against a reference map as it goes.  The sequences are drawn
+from a fixed seed (see SEED), so any failure is reproducible.
+
+
+License
+-------
+
+This package is released under the MIT License.
+See included file 'LICENSE' for more details.
+
+
+Contributors
+------------
+
+David Farell <DavidPFarrell@yahoo.com>
+
+*/
+package bsttest
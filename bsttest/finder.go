@@ -0,0 +1,101 @@
+package bsttest
+
+import (
+	"testing"
+)
+
+import (
+	"github.com/iNamik/go_bst/finder"
+	"github.com/iNamik/go_cmp"
+)
+
+// RunFinder exercises the finder.I contract (Find), both directly and
+// through the helper functions of the finder package.
+// Each test creates its own tree by calling fnew.
+func RunFinder(t *testing.T, fnew F_Finder) {
+	t.Run("Empty", func(t *testing.T) {
+		tree := fnew(cmp.F_int)
+		k, v, found := tree.Find(func(n finder.Node) finder.Action {
+			t.Fatalf("Find() called f on an empty tree")
+			return finder.NOT_FOUND
+		})
+		if k != nil || v != nil || found {
+			t.Fatalf("Find() returned (%v, %v, %v) on an empty tree", k, v, found)
+		}
+	})
+
+	t.Run("Node", func(t *testing.T) {
+		tree := fnew(cmp.F_int)
+		m := fill(tree, SIZE, 1, newRand())
+		// Follow the path to every key, confirming each node along the way
+		for k := range m {
+			_, _, found := tree.Find(func(n finder.Node) finder.Action {
+				if n.Value() != m[n.Key().(int)] {
+					t.Fatalf("node '%v' has value '%v'", n.Key(), n.Value())
+				}
+				if c := n.Cmp(k, n.Key()); c != cmp.F_int(k, n.Key()) {
+					t.Fatalf("Cmp(%v, %v) returned %d", k, n.Key(), c)
+				}
+				switch cmp.F_int(k, n.Key()) {
+				case cmp.LT:
+					if !n.HasLeft() {
+						t.Fatalf("node '%v' has no left child, but '%d' is in the tree", n.Key(), k)
+					}
+					return finder.LEFT
+				case cmp.GT:
+					if !n.HasRight() {
+						t.Fatalf("node '%v' has no right child, but '%d' is in the tree", n.Key(), k)
+					}
+					return finder.RIGHT
+				default:
+					return finder.FOUND
+				}
+			})
+			if !found {
+				t.Fatalf("Find() did not find '%d'", k)
+			}
+		}
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		tree := fnew(cmp.F_int)
+		fill(tree, SIZE, 1, newRand())
+		if _, _, found := tree.Find(func(n finder.Node) finder.Action { return finder.NOT_FOUND }); found {
+			t.Fatalf("Find() returned true for NOT_FOUND")
+		}
+		// Running off the bottom of the tree is not found
+		if _, _, found := tree.Find(func(n finder.Node) finder.Action { return finder.LEFT }); found {
+			t.Fatalf("Find() returned true after running off the left of the tree")
+		}
+		if _, _, found := tree.Find(func(n finder.Node) finder.Action { return finder.RIGHT }); found {
+			t.Fatalf("Find() returned true after running off the right of the tree")
+		}
+	})
+
+	t.Run("Illegal", func(t *testing.T) {
+		tree := fnew(cmp.F_int)
+		tree.ReplaceOrInsert(1, 1)
+		checkPanic(t, "Find(Action(-1))", func() {
+			tree.Find(func(n finder.Node) finder.Action { return finder.Action(-1) })
+		})
+		checkTree(t, tree, model{1: 1})
+	})
+
+	t.Run("Helpers", func(t *testing.T) {
+		tree := fnew(cmp.F_int)
+		m := fill(tree, SIZE, 2, newRand()) // Even keys only
+		keys := m.keys()
+		for k := -1; k <= SIZE*2; k++ {
+			value, found := finder.Get(tree, k)
+			value_, found_ := m[k]
+			if found != found_ || value != value_ {
+				t.Fatalf("finder.Get(%d) returned (%v, %v) instead of (%v, %v)", k, value, found, value_, found_)
+			}
+		}
+		checkKVF(t, "finder.Min()", func() (interface{}, interface{}, bool) { return finder.Min(tree) }, keys[0], keys[0], true)
+		checkKVF(t, "finder.Max()", func() (interface{}, interface{}, bool) { return finder.Max(tree) }, keys[len(keys)-1], keys[len(keys)-1], true)
+		checkBounds(t, m,
+			func(k interface{}) (interface{}, interface{}, bool) { return finder.LowerBound(tree, k) },
+			func(k interface{}) (interface{}, interface{}, bool) { return finder.UpperBound(tree, k) })
+	})
+}
@@ -0,0 +1,93 @@
+package bsttest
+
+import (
+	"testing"
+)
+
+import (
+	"github.com/iNamik/go_cmp"
+)
+
+// RunT exercises the bst.T contract (Empty, ReplaceOrInsert, Get, Remove),
+// along with Size, Min and Max when the tree implements them.
+// Each test creates its own tree by calling fnew.
+func RunT(t *testing.T, fnew F_T) {
+	t.Run("Empty", func(t *testing.T) {
+		tree := fnew(cmp.F_int)
+		checkTree(t, tree, model{})
+		if tree.Remove(1) {
+			t.Fatalf("Remove(1) returned true on an empty tree")
+		}
+		checkTree(t, tree, model{})
+	})
+
+	t.Run("ReplaceOrInsert", func(t *testing.T) {
+		tree := fnew(cmp.F_int)
+		if tree.ReplaceOrInsert(1, "a") {
+			t.Fatalf("ReplaceOrInsert(1) returned true when inserting")
+		}
+		checkTree(t, tree, model{1: "a"})
+		if !tree.ReplaceOrInsert(1, "b") {
+			t.Fatalf("ReplaceOrInsert(1) returned false when replacing")
+		}
+		checkTree(t, tree, model{1: "b"})
+	})
+
+	t.Run("Remove", func(t *testing.T) {
+		tree := fnew(cmp.F_int)
+		tree.ReplaceOrInsert(2, 2)
+		if tree.Remove(1) || tree.Remove(3) {
+			t.Fatalf("Remove() returned true for a key not in the tree")
+		}
+		checkTree(t, tree, model{2: 2})
+		if !tree.Remove(2) {
+			t.Fatalf("Remove(2) returned false")
+		}
+		checkTree(t, tree, model{})
+	})
+
+	t.Run("Sorted", func(t *testing.T) {
+		tree := fnew(cmp.F_int)
+		m := model{}
+		for k := 0; k < SIZE; k++ {
+			tree.ReplaceOrInsert(k, k)
+			m[k] = k
+		}
+		checkTree(t, tree, m)
+		for k := SIZE - 1; k >= 0; k -= 2 {
+			if !tree.Remove(k) {
+				t.Fatalf("Remove(%d) returned false", k)
+			}
+			delete(m, k)
+		}
+		checkTree(t, tree, m)
+	})
+
+	t.Run("Random", func(t *testing.T) {
+		r := newRand()
+		tree := fnew(cmp.F_int)
+		m := model{}
+		for i := 0; i < COUNT; i++ {
+			k := r.Intn(SIZE)
+			_, exists := m[k]
+			switch r.Intn(3) {
+			case 0:
+				if replaced := tree.ReplaceOrInsert(k, i); replaced != exists {
+					t.Fatalf("ReplaceOrInsert(%d) returned %v instead of %v", k, replaced, exists)
+				}
+				m[k] = i
+			case 1:
+				if removed := tree.Remove(k); removed != exists {
+					t.Fatalf("Remove(%d) returned %v instead of %v", k, removed, exists)
+				}
+				delete(m, k)
+			default:
+				checkGet(t, tree, k, m)
+			}
+			if i%CHECK == 0 {
+				checkTree(t, tree, m)
+			}
+		}
+		checkTree(t, tree, m)
+	})
+}
@@ -0,0 +1,159 @@
+package bsttest
+
+import (
+	"testing"
+)
+
+import (
+	"github.com/iNamik/go_bst/visitor"
+	"github.com/iNamik/go_cmp"
+)
+
+// RunVisitor exercises the visitor.I contract (Visit), both directly and
+// through the helper functions of the visitor package.
+// Each test creates its own tree by calling fnew.
+func RunVisitor(t *testing.T, fnew F_Visitor) {
+	t.Run("Found", func(t *testing.T) {
+		tree := fnew(cmp.F_int)
+		m := fill(tree, SIZE, 1, newRand())
+		checkVisit(t, tree, 10, visitor.GET, 0, 10, visitor.FOUND)
+		checkVisit(t, tree, 10, visitor.REPLACE, -10, -10, visitor.REPLACED)
+		m[10] = -10
+		checkTree(t, tree, m)
+		checkVisit(t, tree, 10, visitor.REMOVE, 0, -10, visitor.REMOVED)
+		delete(m, 10)
+		checkTree(t, tree, m)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		tree := fnew(cmp.F_int)
+		m := fill(tree, SIZE, 2, newRand())
+		checkVisit(t, tree, 11, visitor.GET, 0, nil, visitor.NOT_FOUND)
+		checkTree(t, tree, m)
+		checkVisit(t, tree, 11, visitor.INSERT, 11, 11, visitor.INSERTED)
+		m[11] = 11
+		checkTree(t, tree, m)
+	})
+
+	t.Run("Illegal", func(t *testing.T) {
+		tree := fnew(cmp.F_int)
+		m := fill(tree, SIZE, 2, newRand())
+		for _, a := range []visitor.Action{visitor.INSERT, visitor.Action(-1)} {
+			checkPanic(t, "Visit(found, "+a.String()+")", func() {
+				tree.Visit(10, func(interface{}, bool) (interface{}, visitor.Action) { return 0, a })
+			})
+		}
+		for _, a := range []visitor.Action{visitor.REPLACE, visitor.REMOVE, visitor.Action(-1)} {
+			checkPanic(t, "Visit(not-found, "+a.String()+")", func() {
+				tree.Visit(11, func(interface{}, bool) (interface{}, visitor.Action) { return 0, a })
+			})
+		}
+		checkTree(t, tree, m)
+	})
+
+	t.Run("Random", func(t *testing.T) {
+		r := newRand()
+		tree := fnew(cmp.F_int)
+		m := model{}
+		for i := 0; i < COUNT; i++ {
+			k := r.Intn(SIZE)
+			checkHelper(t, tree, m, r.Intn(10), k, i)
+			if i%CHECK == 0 {
+				checkTree(t, tree, m)
+			}
+		}
+		checkTree(t, tree, m)
+	})
+}
+
+// checkVisit visits key, taking action with newValue, and confirms the results
+func checkVisit(t *testing.T, tree Visitor, key int, action visitor.Action, newValue interface{}, value interface{}, result visitor.Result) {
+	v_, result_ := tree.Visit(key, func(interface{}, bool) (interface{}, visitor.Action) {
+		return newValue, action
+	})
+	if result_ != result {
+		t.Fatalf("Visit(%d, %s) returned result '%s' instead of '%s'", key, action, result_, result)
+	}
+	if result != visitor.NOT_FOUND && v_ != value {
+		t.Fatalf("Visit(%d, %s) returned value '%v' instead of '%v'", key, action, v_, value)
+	}
+}
+
+// checkHelper calls the visitor helper numbered h on both the tree and
+// the model, and confirms that they agree
+func checkHelper(t *testing.T, tree Visitor, m model, h int, key int, newValue interface{}) {
+	old, found := m[key]
+	var name string
+	var value, value_ interface{}
+	var ok, ok_ bool
+	switch h {
+	case 0:
+		name = "Get"
+		value, ok = visitor.Get(tree, key)
+		value_, ok_ = old, found
+	case 1:
+		name = "GetOrInsert"
+		value, ok = visitor.GetOrInsert(tree, key, newValue)
+		value_, ok_ = old, found
+		if !found {
+			value_, m[key] = newValue, newValue
+		}
+	case 2:
+		name = "GetAndReplace"
+		value, ok = visitor.GetAndReplace(tree, key, newValue)
+		value_, ok_ = old, found
+		if found {
+			m[key] = newValue
+		}
+	case 3:
+		name = "GetAndReplaceOrInsert"
+		value, ok = visitor.GetAndReplaceOrInsert(tree, key, newValue)
+		value_, ok_ = old, found
+		if !found {
+			value_ = newValue
+		}
+		m[key] = newValue
+	case 4:
+		name = "GetAndRemove"
+		value, ok = visitor.GetAndRemove(tree, key)
+		value_, ok_ = old, found
+		delete(m, key)
+	case 5:
+		name = "GetAndRemoveOrInsert"
+		value, ok = visitor.GetAndRemoveOrInsert(tree, key, newValue)
+		value_, ok_ = old, found
+		if found {
+			delete(m, key)
+		} else {
+			value_, m[key] = newValue, newValue
+		}
+	case 6:
+		name = "Replace"
+		ok, ok_ = visitor.Replace(tree, key, newValue), found
+		if found {
+			m[key] = newValue
+		}
+	case 7:
+		name = "ReplaceOrInsert"
+		ok, ok_ = visitor.ReplaceOrInsert(tree, key, newValue), found
+		m[key] = newValue
+	case 8:
+		name = "Remove"
+		ok, ok_ = visitor.Remove(tree, key), found
+		delete(m, key)
+	default:
+		name = "RemoveOrInsert"
+		ok, ok_ = visitor.RemoveOrInsert(tree, key, newValue), found
+		if found {
+			delete(m, key)
+		} else {
+			m[key] = newValue
+		}
+	}
+	if ok != ok_ {
+		t.Fatalf("visitor.%s(%d) returned %v instead of %v", name, key, ok, ok_)
+	}
+	if value != value_ {
+		t.Fatalf("visitor.%s(%d) returned value '%v' instead of '%v'", name, key, value, value_)
+	}
+}
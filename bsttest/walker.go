@@ -0,0 +1,292 @@
+package bsttest
+
+import (
+	"fmt"
+	"iter"
+	"math/rand"
+	"testing"
+)
+
+import (
+	"github.com/iNamik/go_bst/walker"
+	"github.com/iNamik/go_cmp"
+)
+
+// RunWalker exercises the walker.I contract (Walk), both directly and
+// through the helper functions of the walker package.
+// Each test creates its own tree by calling fnew.
+func RunWalker(t *testing.T, fnew F_Walker) {
+	t.Run("Empty", func(t *testing.T) {
+		tree := fnew(cmp.F_int)
+		tree.Walk(func(n walker.Node) walker.Action {
+			t.Fatalf("Walk() called f on an empty tree")
+			return walker.RETURN
+		})
+	})
+
+	t.Run("Structure", func(t *testing.T) {
+		tree := fnew(cmp.F_int)
+		m := fill(tree, SIZE, 1, newRand())
+		checkStructure(t, tree, m.keys())
+	})
+
+	t.Run("Sequence", func(t *testing.T) {
+		r := newRand()
+		tree := fnew(cmp.F_int)
+		m := fill(tree, SIZE, 1, r)
+		checkSequence(t, tree, m.keys(), r)
+	})
+
+	t.Run("Illegal", func(t *testing.T) {
+		tree := fnew(cmp.F_int)
+		tree.ReplaceOrInsert(1, 1)
+		for _, a := range []walker.Action{walker.LEFT, walker.RIGHT, walker.PREV, walker.NEXT, walker.PARENT, walker.Action(-1)} {
+			checkPanic(t, "Walk("+a.String()+")", func() {
+				tree.Walk(func(n walker.Node) walker.Action { return a })
+			})
+		}
+		checkTree(t, tree, model{1: 1})
+	})
+
+	t.Run("Helpers", func(t *testing.T) {
+		tree := fnew(cmp.F_int)
+		m := fill(tree, SIZE, 2, newRand()) // Even keys only
+		keys := m.keys()
+		for k := -1; k <= SIZE*2; k++ {
+			value, found := walker.Get(tree, k)
+			value_, found_ := m[k]
+			if found != found_ || value != value_ {
+				t.Fatalf("walker.Get(%d) returned (%v, %v) instead of (%v, %v)", k, value, found, value_, found_)
+			}
+		}
+		checkKVF(t, "walker.Min()", func() (interface{}, interface{}, bool) { return walker.Min(tree) }, keys[0], keys[0], true)
+		checkKVF(t, "walker.Max()", func() (interface{}, interface{}, bool) { return walker.Max(tree) }, keys[len(keys)-1], keys[len(keys)-1], true)
+		checkBounds(t, m,
+			func(k interface{}) (interface{}, interface{}, bool) { return walker.LowerBound(tree, k) },
+			func(k interface{}) (interface{}, interface{}, bool) { return walker.UpperBound(tree, k) })
+		i := 0
+		walker.ForeachMin(tree, func(k interface{}, v interface{}) {
+			if i >= len(keys) || k != keys[i] || v != m[keys[i]] {
+				t.Fatalf("walker.ForeachMin() visited (%v, %v) at index %d", k, v, i)
+			}
+			i++
+		})
+		if i != len(keys) {
+			t.Fatalf("walker.ForeachMin() visited %d keys instead of %d", i, len(keys))
+		}
+		walker.ForeachMax(tree, func(k interface{}, v interface{}) {
+			i--
+			if i < 0 || k != keys[i] || v != m[keys[i]] {
+				t.Fatalf("walker.ForeachMax() visited (%v, %v) at index %d", k, v, i)
+			}
+		})
+		if i != 0 {
+			t.Fatalf("walker.ForeachMax() visited %d keys instead of %d", len(keys)-i, len(keys))
+		}
+	})
+
+	t.Run("Range", func(t *testing.T) {
+		r := newRand()
+		tree := fnew(cmp.F_int)
+		m := fill(tree, SIZE, 2, r) // Even keys only
+		keys := m.keys()
+		for i := 0; i < 100; i++ {
+			lo, hi := r.Intn(SIZE*2+2)-1, r.Intn(SIZE*2+2)-1
+			if lo > hi && i%10 != 0 { // Keep a few empty ranges
+				lo, hi = hi, lo
+			}
+			inclusiveLo, inclusiveHi := r.Intn(2) == 0, r.Intn(2) == 0
+			var expected []int
+			for _, k := range keys {
+				if (k > lo || (k == lo && inclusiveLo)) && (k < hi || (k == hi && inclusiveHi)) {
+					expected = append(expected, k)
+				}
+			}
+			var visited []int
+			walker.ForeachRange(tree, lo, hi, inclusiveLo, inclusiveHi, func(k interface{}, v interface{}) {
+				visited = append(visited, k.(int))
+			})
+			checkKeys(t, fmt.Sprintf("walker.ForeachRange(%d, %d, %v, %v)", lo, hi, inclusiveLo, inclusiveHi), visited, expected)
+			visited = visited[:0]
+			walker.ForeachRangeReverse(tree, lo, hi, inclusiveLo, inclusiveHi, func(k interface{}, v interface{}) {
+				visited = append(visited, k.(int))
+			})
+			for j, k := 0, len(visited)-1; j < k; j, k = j+1, k-1 {
+				visited[j], visited[k] = visited[k], visited[j]
+			}
+			checkKeys(t, fmt.Sprintf("walker.ForeachRangeReverse(%d, %d, %v, %v)", lo, hi, inclusiveLo, inclusiveHi), visited, expected)
+		}
+	})
+
+	t.Run("While", func(t *testing.T) {
+		r := newRand()
+		tree := fnew(cmp.F_int)
+		m := fill(tree, SIZE, 2, r) // Even keys only
+		keys := m.keys()
+		reversed := make([]int, len(keys))
+		for i, k := range keys {
+			reversed[len(keys)-1-i] = k
+		}
+		for i := 0; i < 100; i++ {
+			n := r.Intn(len(keys) + 2) // Includes stopping at the first key and never stopping
+			lo := r.Intn(len(keys))
+			hi := lo + r.Intn(len(keys)-lo)
+			var visited []int
+			// collect returns false once n keys have been visited
+			collect := func(k interface{}, v interface{}) bool {
+				visited = append(visited, k.(int))
+				return len(visited) < n
+			}
+			// prefix returns the first n keys, or at least one key, of keys
+			prefix := func(keys []int) []int {
+				if n < len(keys) {
+					if n == 0 {
+						return keys[:1]
+					}
+					return keys[:n]
+				}
+				return keys
+			}
+			visited = visited[:0]
+			walker.ForeachMinWhile(tree, collect)
+			checkKeys(t, fmt.Sprintf("walker.ForeachMinWhile(%d)", n), visited, prefix(keys))
+			visited = visited[:0]
+			walker.ForeachMaxWhile(tree, collect)
+			checkKeys(t, fmt.Sprintf("walker.ForeachMaxWhile(%d)", n), visited, prefix(reversed))
+			visited = visited[:0]
+			walker.ForeachRangeWhile(tree, keys[lo], keys[hi], true, true, collect)
+			checkKeys(t, fmt.Sprintf("walker.ForeachRangeWhile(%d, %d, %d)", keys[lo], keys[hi], n), visited, prefix(keys[lo:hi+1]))
+			visited = visited[:0]
+			walker.ForeachRangeReverseWhile(tree, keys[lo], keys[hi], true, true, collect)
+			checkKeys(t, fmt.Sprintf("walker.ForeachRangeReverseWhile(%d, %d, %d)", keys[lo], keys[hi], n), visited, prefix(reversed[len(keys)-1-hi:len(keys)-lo]))
+		}
+	})
+
+	t.Run("Iter", func(t *testing.T) {
+		r := newRand()
+		tree := fnew(cmp.F_int)
+		m := fill(tree, SIZE, 2, r) // Even keys only
+		keys := m.keys()
+		reversed := make([]int, len(keys))
+		for i, k := range keys {
+			reversed[len(keys)-1-i] = k
+		}
+		for i := 0; i < 100; i++ {
+			n := r.Intn(len(keys)) + 1 // Break after n keys, or never when n == len(keys)
+			lo := r.Intn(len(keys))
+			hi := lo + r.Intn(len(keys)-lo)
+			// collect ranges over seq, breaking after n keys
+			collect := func(seq iter.Seq2[interface{}, interface{}]) []int {
+				var visited []int
+				for k, v := range seq {
+					if v != m[k.(int)] {
+						t.Fatalf("iterator yielded (%v, %v) instead of (%v, %v)", k, v, k, m[k.(int)])
+					}
+					if visited = append(visited, k.(int)); len(visited) == n {
+						break
+					}
+				}
+				return visited
+			}
+			// prefix returns the first n keys of keys
+			prefix := func(keys []int) []int {
+				if n < len(keys) {
+					return keys[:n]
+				}
+				return keys
+			}
+			checkKeys(t, fmt.Sprintf("walker.All(%d)", n), collect(walker.All(tree)), prefix(keys))
+			checkKeys(t, fmt.Sprintf("walker.Backward(%d)", n), collect(walker.Backward(tree)), prefix(reversed))
+			checkKeys(t, fmt.Sprintf("walker.Range(%d, %d, %d)", keys[lo], keys[hi], n), collect(walker.Range(tree, keys[lo], keys[hi], true, true)), prefix(keys[lo:hi+1]))
+			checkKeys(t, fmt.Sprintf("walker.RangeBackward(%d, %d, %d)", keys[lo], keys[hi], n), collect(walker.RangeBackward(tree, keys[lo], keys[hi], true, true)), prefix(reversed[len(keys)-1-hi:len(keys)-lo]))
+		}
+	})
+}
+
+// checkKeys confirms that the keys visited match the keys expected
+func checkKeys(t *testing.T, name string, visited []int, expected []int) {
+	if len(visited) != len(expected) {
+		t.Fatalf("%s visited %d keys instead of %d", name, len(visited), len(expected))
+	}
+	for i := range expected {
+		if visited[i] != expected[i] {
+			t.Fatalf("%s visited '%d' instead of '%d' at index %d", name, visited[i], expected[i], i)
+		}
+	}
+}
+
+// checkStructure walks the entire tree using LEFT, RIGHT and PARENT,
+// confirming that an in-order traversal visits keys in order, and that
+// Level, HasParent, HasPrev and HasNext are consistent
+func checkStructure(t *testing.T, tree Walker, keys []int) {
+	var (
+		i     int   // Index of the key expected next
+		stack []int // Per level, 0 = new, 1 = visited left, 2 = visited right
+	)
+	tree.Walk(func(n walker.Node) walker.Action {
+		level := n.Level()
+		if level == len(stack)+1 {
+			// First visit to this node
+			stack = append(stack, 0)
+			if n.HasParent() != (level > 1) {
+				t.Fatalf("node '%v' at level %d returned HasParent() %v", n.Key(), level, n.HasParent())
+			}
+		} else if level != len(stack) {
+			t.Fatalf("node '%v' is at level %d instead of %d", n.Key(), level, len(stack))
+		}
+		if stack[level-1] == 0 {
+			stack[level-1] = 1
+			if n.HasLeft() {
+				return walker.LEFT
+			}
+		}
+		if stack[level-1] == 1 {
+			if n.Key() != keys[i] {
+				t.Fatalf("encountered '%v' instead of '%d'", n.Key(), keys[i])
+			}
+			if n.HasPrev() != (i > 0) || n.HasNext() != (i < len(keys)-1) {
+				t.Fatalf("node '%v' returned HasPrev() %v and HasNext() %v", n.Key(), n.HasPrev(), n.HasNext())
+			}
+			i++
+			stack[level-1] = 2
+			if n.HasRight() {
+				return walker.RIGHT
+			}
+		}
+		stack = stack[:level-1]
+		if level == 1 {
+			return walker.RETURN
+		}
+		return walker.PARENT
+	})
+	if i != len(keys) {
+		t.Fatalf("walk visited %d keys instead of %d", i, len(keys))
+	}
+}
+
+// checkSequence walks randomly back and forth through the tree using
+// PREV and NEXT, confirming each key against the sorted keys
+func checkSequence(t *testing.T, tree Walker, keys []int, r *rand.Rand) {
+	count := COUNT
+	i := -1 // Index of the key expected next, -1 = descend to the root's min first
+	tree.Walk(func(n walker.Node) walker.Action {
+		if i < 0 {
+			if n.HasLeft() {
+				return walker.LEFT
+			}
+			i = 0
+		}
+		if n.Key() != keys[i] {
+			t.Fatalf("encountered '%v' instead of '%d'", n.Key(), keys[i])
+		}
+		if count--; count == 0 {
+			return walker.RETURN
+		}
+		if (r.Intn(2) == 0 && n.HasPrev()) || !n.HasNext() {
+			i--
+			return walker.PREV
+		}
+		i++
+		return walker.NEXT
+	})
+}
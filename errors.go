@@ -0,0 +1,55 @@
+package bst
+
+import (
+	"errors"
+	"fmt"
+)
+
+/**********************************************************************
+ ** Errors
+ **********************************************************************/
+
+// ErrIllegalAction is reported when a Find, Visit or Walk call-back
+// returns an action that is not valid at that point
+var ErrIllegalAction = errors.New("illegal action")
+
+// ErrNoSuchNeighbor is reported when a Walk call-back tries to move to
+// a node that does not exist, such as LEFT when HasLeft() == false
+var ErrNoSuchNeighbor = errors.New("no such neighbor")
+
+// ActionError reports an action, returned by a Find, Visit or Walk
+// call-back, that could not be carried out.
+// Err is either ErrIllegalAction or ErrNoSuchNeighbor.
+type ActionError struct {
+	Op     string // "find", "visit" or "walk"
+	Action fmt.Stringer
+	Err    error
+}
+
+// ActionError::Error
+func (e *ActionError) Error() string {
+	return fmt.Sprintf("%s: %s '%s'", e.Op, e.Err, e.Action)
+}
+
+// ActionError::Unwrap
+func (e *ActionError) Unwrap() error {
+	return e.Err
+}
+
+// PanicError reports a panic raised by a Find, Visit or Walk call-back.
+// Value is the value that was passed to panic.
+type PanicError struct {
+	Op    string // "find", "visit" or "walk"
+	Value interface{}
+}
+
+// PanicError::Error
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("%s: call-back panicked: %v", e.Op, e.Value)
+}
+
+// PanicError::Unwrap returns Value if it is an error
+func (e *PanicError) Unwrap() error {
+	err, _ := e.Value.(error)
+	return err
+}
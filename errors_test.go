@@ -0,0 +1,61 @@
+package bst_test
+
+import (
+	"errors"
+	"testing"
+)
+
+import (
+	"github.com/iNamik/go_bst"
+)
+
+/**********************************************************************
+ ** Types
+ **********************************************************************/
+
+// action allows us to use a string as an action
+type action string
+
+// action::String
+func (a action) String() string { return string(a) }
+
+/**********************************************************************
+ ** Test Functions
+ **********************************************************************/
+
+// Test_ActionError
+func Test_ActionError(t *testing.T) {
+	var err error = &bst.ActionError{Op: "walk", Action: action("LEFT"), Err: bst.ErrNoSuchNeighbor}
+	if err.Error() != "walk: no such neighbor 'LEFT'" {
+		t.Fatalf("Error() returned '%s'", err)
+	}
+	if errors.Unwrap(err) != bst.ErrNoSuchNeighbor {
+		t.Fatalf("Unwrap() returned '%v' instead of '%v'", errors.Unwrap(err), bst.ErrNoSuchNeighbor)
+	}
+	if errors.Is(err, bst.ErrNoSuchNeighbor) == false || errors.Is(err, bst.ErrIllegalAction) == true {
+		t.Fatalf("errors.Is() does not match the wrapped error")
+	}
+	var e *bst.ActionError
+	if errors.As(err, &e) == false || e.Op != "walk" {
+		t.Fatalf("errors.As() did not return the *bst.ActionError")
+	}
+}
+
+// Test_PanicError
+func Test_PanicError(t *testing.T) {
+	var err error = &bst.PanicError{Op: "find", Value: "oops"}
+	if err.Error() != "find: call-back panicked: oops" {
+		t.Fatalf("Error() returned '%s'", err)
+	}
+	if errors.Unwrap(err) != nil {
+		t.Fatalf("Unwrap() returned '%v' for a non-error value", errors.Unwrap(err))
+	}
+	cause := errors.New("oops")
+	err = &bst.PanicError{Op: "visit", Value: cause}
+	if err.Error() != "visit: call-back panicked: oops" {
+		t.Fatalf("Error() returned '%s'", err)
+	}
+	if errors.Unwrap(err) != cause || errors.Is(err, cause) == false {
+		t.Fatalf("Unwrap() returned '%v' instead of '%v'", errors.Unwrap(err), cause)
+	}
+}
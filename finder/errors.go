@@ -0,0 +1,132 @@
+package finder
+
+import (
+	"github.com/iNamik/go_bst"
+)
+
+/**********************************************************************
+ ** FindE
+ **********************************************************************/
+
+// I_FindE
+type I_FindE interface {
+	// FindE is Find, but reports an illegal action, or a panic in f,
+	// as an error instead of panicking.  The search stops at the
+	// offending node, reporting not found.
+	FindE(f F) (key interface{}, value interface{}, found bool, err error)
+}
+
+// t::FindE
+func (f *t) FindE(fn F) (interface{}, interface{}, bool, error) {
+	return FindE(f.i, fn)
+}
+
+// FindE checks each action returned by fn before passing it on to the
+// tree, and recovers panics in fn, so works with any implementation of I.
+// Errors are reported as *bst.ActionError or *bst.PanicError.
+func FindE(f I, fn F) (key interface{}, value interface{}, found bool, err error) {
+	key, value, found = f.Find(func(node Node) (action Action) {
+		defer func() {
+			if r := recover(); r != nil {
+				err, action = &bst.PanicError{Op: "find", Value: r}, NOT_FOUND
+			}
+		}()
+		switch action = fn(node); action {
+		case LEFT, RIGHT, FOUND, NOT_FOUND:
+			return action
+		}
+		err = &bst.ActionError{Op: "find", Action: action, Err: bst.ErrIllegalAction}
+		return NOT_FOUND
+	})
+	if err != nil {
+		return nil, nil, false, err
+	}
+	return key, value, found, nil
+}
+
+/**********************************************************************
+ ** HelpersE
+ **********************************************************************/
+
+// I_HelpersE contains the error-returning versions of the helper methods,
+// which report a panic in the comparator as a *bst.PanicError
+type I_HelpersE interface {
+	GetE(key interface{}) (value interface{}, found bool, err error)
+	MinE() (key interface{}, value interface{}, found bool, err error)
+	MaxE() (key interface{}, value interface{}, found bool, err error)
+	LowerBoundE(boundKey interface{}) (key interface{}, value interface{}, found bool, err error)
+	UpperBoundE(boundKey interface{}) (key interface{}, value interface{}, found bool, err error)
+}
+
+// t::GetE
+func (f *t) GetE(key interface{}) (interface{}, bool, error) {
+	return GetE(f.i, key)
+}
+
+// t::MinE
+func (f *t) MinE() (interface{}, interface{}, bool, error) {
+	return MinE(f.i)
+}
+
+// t::MaxE
+func (f *t) MaxE() (interface{}, interface{}, bool, error) {
+	return MaxE(f.i)
+}
+
+// t::LowerBoundE
+func (f *t) LowerBoundE(boundKey interface{}) (interface{}, interface{}, bool, error) {
+	return LowerBoundE(f.i, boundKey)
+}
+
+// t::UpperBoundE
+func (f *t) UpperBoundE(boundKey interface{}) (interface{}, interface{}, bool, error) {
+	return UpperBoundE(f.i, boundKey)
+}
+
+// GetE (see Get)
+func GetE(f I, key interface{}) (value interface{}, found bool, err error) {
+	if value, found = get(findE(f, &err), key); err != nil {
+		return nil, false, err
+	}
+	return value, found, nil
+}
+
+// MinE (see Min)
+func MinE(f I) (key interface{}, value interface{}, found bool, err error) {
+	if key, value, found = findMin(findE(f, &err)); err != nil {
+		return nil, nil, false, err
+	}
+	return key, value, found, nil
+}
+
+// MaxE (see Max)
+func MaxE(f I) (key interface{}, value interface{}, found bool, err error) {
+	if key, value, found = findMax(findE(f, &err)); err != nil {
+		return nil, nil, false, err
+	}
+	return key, value, found, nil
+}
+
+// LowerBoundE (see LowerBound)
+func LowerBoundE(f I, boundKey interface{}) (key interface{}, value interface{}, found bool, err error) {
+	if key, value, found = lowerBound(findE(f, &err), boundKey); err != nil {
+		return nil, nil, false, err
+	}
+	return key, value, found, nil
+}
+
+// UpperBoundE (see UpperBound)
+func UpperBoundE(f I, boundKey interface{}) (key interface{}, value interface{}, found bool, err error) {
+	if key, value, found = upperBound(findE(f, &err), boundKey); err != nil {
+		return nil, nil, false, err
+	}
+	return key, value, found, nil
+}
+
+// findE adapts FindE to the signature of I.Find, storing any error in err
+func findE(f I, err *error) findFunc {
+	return func(fn F) (key interface{}, value interface{}, found bool) {
+		key, value, found, *err = FindE(f, fn)
+		return
+	}
+}
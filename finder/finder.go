@@ -41,6 +41,23 @@ This package uses Find to implement several common BST methods:
  * UpperBound (see finder.I_UpperBound)
 
 
+Errors
+------
+
+Implementations typically panic when the Find call-back returns an
+action other than LEFT, RIGHT, FOUND or NOT_FOUND.  FindE reports
+these as a *bst.ActionError (wrapping bst.ErrIllegalAction), and a
+panic in the call-back as a *bst.PanicError, instead of panicking.
+The search stops at the offending node and reports not found.
+
+FindE checks each action before passing it on to the tree, so it
+works with any implementation of Find.
+
+GetE, MinE, MaxE, LowerBoundE and UpperBoundE are the error-returning
+versions of the helpers, built on FindE, and report a panic in the
+comparator as a *bst.PanicError.
+
+
 Efficiency
 ----------
 
@@ -82,6 +99,7 @@ type T interface {
 	I_Max
 	I_LowerBound
 	I_UpperBound
+	I_HelpersE
 }
 
 // I defines the extensible finder interface
@@ -92,6 +110,9 @@ type I interface {
 // F defines the call-back function used for the Find method
 type F func(Node) Action
 
+// findFunc is the signature of I.Find, which the helper functions are built on
+type findFunc func(f F) (key interface{}, value interface{}, found bool)
+
 // t
 type t struct{ i I }
 
@@ -166,7 +187,12 @@ func (f *t) Get(key interface{}) (interface{}, bool) {
 
 // Get
 func Get(f I, key interface{}) (value interface{}, found bool) {
-	_, value, found = f.Find(func(node Node) Action {
+	return get(f.Find, key)
+}
+
+// get implements Get using find, which is either I.Find or FindE
+func get(find findFunc, key interface{}) (value interface{}, found bool) {
+	_, value, found = find(func(node Node) Action {
 		switch node.Cmp(key, node.Key()) { // key <=> node
 		case cmp.LT: // key < node
 			return LEFT
@@ -196,7 +222,12 @@ func (f *t) Min() (interface{}, interface{}, bool) {
 
 // Min
 func Min(f I) (interface{}, interface{}, bool) {
-	return f.Find(func(node Node) Action {
+	return findMin(f.Find)
+}
+
+// findMin implements Min using find
+func findMin(find findFunc) (interface{}, interface{}, bool) {
+	return find(func(node Node) Action {
 		if node.HasLeft() {
 			return LEFT
 		}
@@ -221,7 +252,12 @@ func (f *t) Max() (interface{}, interface{}, bool) {
 
 // Max
 func Max(f I) (interface{}, interface{}, bool) {
-	return f.Find(func(node Node) Action {
+	return findMax(f.Find)
+}
+
+// findMax implements Max using find
+func findMax(find findFunc) (interface{}, interface{}, bool) {
+	return find(func(node Node) Action {
 		if node.HasRight() {
 			return RIGHT
 		}
@@ -248,8 +284,13 @@ func (f *t) LowerBound(boundKey interface{}) (interface{}, interface{}, bool) {
 
 // LowerBound
 func LowerBound(f I, boundKey interface{}) (key interface{}, value interface{}, found bool) {
+	return lowerBound(f.Find, boundKey)
+}
+
+// lowerBound implements LowerBound using find
+func lowerBound(find findFunc, boundKey interface{}) (key interface{}, value interface{}, found bool) {
 	key, value, found = nil, nil, false
-	f.Find(func(node Node) Action {
+	find(func(node Node) Action {
 		switch node.Cmp(node.Key(), boundKey) {
 		case cmp.LT: // node < boundKey - We have a candidate
 			// If node > working then update working
@@ -288,8 +329,13 @@ func (f *t) UpperBound(boundKey interface{}) (interface{}, interface{}, bool) {
 
 // UpperBound
 func UpperBound(f I, boundKey interface{}) (key interface{}, value interface{}, found bool) {
+	return upperBound(f.Find, boundKey)
+}
+
+// upperBound implements UpperBound using find
+func upperBound(find findFunc, boundKey interface{}) (key interface{}, value interface{}, found bool) {
 	key, value, found = nil, nil, false
-	f.Find(func(node Node) Action {
+	find(func(node Node) Action {
 		switch node.Cmp(node.Key(), boundKey) {
 		case cmp.GT: // node > boundKey - We have a candidate
 			// If node < working then update working
@@ -4,11 +4,13 @@ package finder_test
 //import . "github.com/iNamik/go_pkg/debug/ping"
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 )
 
 import (
+	"github.com/iNamik/go_bst"
 	"github.com/iNamik/go_bst/finder"
 	"github.com/iNamik/go_cmp"
 )
@@ -26,9 +28,14 @@ func (w f_find) Find(f finder.F) (key interface{}, value interface{}, found bool
 }
 
 func New(nodes []node, t *testing.T) finder.T {
-	return finder.New((f_find)(func(f finder.F) (key interface{}, value interface{}, found bool) {
+	return finder.New(NewI(nodes, t))
+}
+
+// NewI creates a finder.I that walks the call-back through nodes
+func NewI(nodes []node, t *testing.T) finder.I {
+	return (f_find)(func(f finder.F) (key interface{}, value interface{}, found bool) {
 		return assertFind(f, nodes, t)
-	}))
+	})
 }
 
 // this is to get vertical-alignment in my data :)
@@ -102,6 +109,25 @@ func assertActionString(action finder.Action, value string, t *testing.T) {
 	}
 }
 
+// assertActionError confirms that err is a *bst.ActionError wrapping target
+func assertActionError(err error, target error, msg string, t *testing.T) {
+	var e *bst.ActionError
+	if errors.As(err, &e) == false || errors.Is(err, target) == false {
+		t.Fatalf("returned error '%v' instead of a *bst.ActionError wrapping '%v'", err, target)
+	}
+	if err.Error() != msg {
+		t.Fatalf("returned error '%s' instead of '%s'", err, msg)
+	}
+}
+
+// assertPanicError confirms that err is a *bst.PanicError
+func assertPanicError(err error, t *testing.T) {
+	var e *bst.PanicError
+	if errors.As(err, &e) == false {
+		t.Fatalf("returned error '%v' instead of a *bst.PanicError", err)
+	}
+}
+
 /**********************************************************************
  ** Test Functions
  **********************************************************************/
@@ -333,3 +359,121 @@ func Test_Action_String(t *testing.T) {
 
 	assertActionString(finder.Action(-1), "finder.Action(-1)", t)
 }
+
+// Test_FindE
+func Test_FindE(t *testing.T) {
+	nodes := []node{
+		{key: 0, value: 0, hasLeft: FALSE, hasRight: TRUE_, action: finder.RIGHT},
+		{key: 1, value: 1, hasLeft: FALSE, hasRight: FALSE, action: finder.FOUND},
+	}
+	key, value, found, err := finder.FindE(NewI(nodes, t), func(n finder.Node) finder.Action {
+		if n.Key() == 0 {
+			return finder.RIGHT
+		}
+		return finder.FOUND
+	})
+	if key != 1 || value != 1 || found != true || err != nil {
+		t.Fatalf("FindE() returned (%v, %v, %v, %v)", key, value, found, err)
+	}
+	nodes = []node{
+		{key: 0, value: 0, hasLeft: FALSE, hasRight: TRUE_, action: finder.NOT_FOUND},
+	}
+	key, value, found, err = finder.FindE(NewI(nodes, t), func(finder.Node) finder.Action {
+		return finder.Action(-1)
+	})
+	if key != nil || value != nil || found != false {
+		t.Fatalf("FindE() returned (%v, %v, %v) for an illegal action", key, value, found)
+	}
+	assertActionError(err, bst.ErrIllegalAction, "find: illegal action 'finder.Action(-1)'", t)
+	_, _, _, err = finder.FindE(NewI(nodes, t), func(finder.Node) finder.Action {
+		panic("oops")
+	})
+	assertPanicError(err, t)
+}
+
+// Test_GetE
+func Test_GetE(t *testing.T) {
+	const VALUE = 1
+	nodes := []node{
+		{key: 0, value: 0, hasLeft: FALSE, hasRight: TRUE_, action: finder.RIGHT},
+		{key: 2, value: 2, hasLeft: TRUE_, hasRight: FALSE, action: finder.LEFT},
+		{key: 1, value: 1, hasLeft: FALSE, hasRight: FALSE, action: finder.FOUND},
+	}
+	value, found, err := New(nodes, t).GetE(VALUE)
+	if value != VALUE || found != true || err != nil {
+		t.Fatalf("GetE() returned (%v, %v, %v)", value, found, err)
+	}
+}
+
+// Test_GetE_Panic confirms that a panic in the comparator is reported
+func Test_GetE_Panic(t *testing.T) {
+	nodes := []node{
+		{key: 0, value: 0, hasLeft: FALSE, hasRight: TRUE_, action: finder.NOT_FOUND},
+	}
+	value, found, err := New(nodes, t).GetE("1") // node::Cmp only takes ints
+	if value != nil || found != false {
+		t.Fatalf("GetE() returned (%v, %v)", value, found)
+	}
+	assertPanicError(err, t)
+}
+
+// Test_MinE
+func Test_MinE(t *testing.T) {
+	nodes := []node{
+		{key: 1, value: 1, hasLeft: TRUE_, hasRight: TRUE_, action: finder.LEFT},
+		{key: 0, value: 0, hasLeft: FALSE, hasRight: FALSE, action: finder.FOUND},
+	}
+	key, value, found, err := New(nodes, t).MinE()
+	if key != 0 || value != 0 || found != true || err != nil {
+		t.Fatalf("MinE() returned (%v, %v, %v, %v)", key, value, found, err)
+	}
+}
+
+// Test_MaxE
+func Test_MaxE(t *testing.T) {
+	nodes := []node{
+		{key: 1, value: 1, hasLeft: TRUE_, hasRight: TRUE_, action: finder.RIGHT},
+		{key: 2, value: 2, hasLeft: FALSE, hasRight: FALSE, action: finder.FOUND},
+	}
+	key, value, found, err := New(nodes, t).MaxE()
+	if key != 2 || value != 2 || found != true || err != nil {
+		t.Fatalf("MaxE() returned (%v, %v, %v, %v)", key, value, found, err)
+	}
+}
+
+// Test_LowerBoundE
+func Test_LowerBoundE(t *testing.T) {
+	nodes := []node{
+		{key: 0, value: 0, hasLeft: FALSE, hasRight: TRUE_, action: finder.RIGHT},
+		{key: 3, value: 3, hasLeft: FALSE, hasRight: FALSE, action: finder.LEFT},
+	}
+	key, value, found, err := New(nodes, t).LowerBoundE(2)
+	if key != 0 || value != 0 || found != true || err != nil {
+		t.Fatalf("LowerBoundE() returned (%v, %v, %v, %v)", key, value, found, err)
+	}
+	nodes = []node{
+		{key: 0, value: 0, hasLeft: FALSE, hasRight: TRUE_, action: finder.NOT_FOUND},
+	}
+	key, value, found, err = New(nodes, t).LowerBoundE("2")
+	if key != nil || value != nil || found != false {
+		t.Fatalf("LowerBoundE() returned (%v, %v, %v)", key, value, found)
+	}
+	assertPanicError(err, t)
+}
+
+// Test_UpperBoundE
+func Test_UpperBoundE(t *testing.T) {
+	nodes := []node{
+		{key: 3, value: 3, hasLeft: TRUE_, hasRight: FALSE, action: finder.LEFT},
+		{key: 0, value: 0, hasLeft: FALSE, hasRight: FALSE, action: finder.RIGHT},
+	}
+	key, value, found, err := New(nodes, t).UpperBoundE(2)
+	if key != 3 || value != 3 || found != true || err != nil {
+		t.Fatalf("UpperBoundE() returned (%v, %v, %v, %v)", key, value, found, err)
+	}
+	nodes = []node{
+		{key: 3, value: 3, hasLeft: TRUE_, hasRight: FALSE, action: finder.NOT_FOUND},
+	}
+	_, _, _, err = New(nodes, t).UpperBoundE("2")
+	assertPanicError(err, t)
+}
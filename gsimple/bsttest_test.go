@@ -0,0 +1,71 @@
+package gsimple
+
+import (
+	"testing"
+)
+
+import (
+	"github.com/iNamik/go_bst"
+	"github.com/iNamik/go_bst/bsttest"
+	"github.com/iNamik/go_bst/finder"
+	"github.com/iNamik/go_bst/typed"
+	"github.com/iNamik/go_bst/visitor"
+	"github.com/iNamik/go_bst/walker"
+	"github.com/iNamik/go_cmp"
+)
+
+/**********************************************************************
+ ** Adapter
+ **********************************************************************/
+
+// adapter exposes a T[interface{}, interface{}] through the interface{}
+// based API, so that it can be run against the conformance suite.
+// Since FinderNode[interface{}, interface{}] and WalkerNode[interface{}, interface{}]
+// satisfy finder.Node and walker.Node, the call-backs pass straight through.
+type adapter struct {
+	T[interface{}, interface{}]
+}
+
+// newAdapter
+func newAdapter(fcmp cmp.F) *adapter {
+	return &adapter{New[interface{}, interface{}](fcmp)}
+}
+
+// adapter::Find
+func (a *adapter) Find(f finder.F) (interface{}, interface{}, bool) {
+	return a.T.Find(func(n typed.FinderNode[interface{}, interface{}]) finder.Action { return f(n) })
+}
+
+// adapter::Visit
+func (a *adapter) Visit(key interface{}, f visitor.F) (interface{}, visitor.Result) {
+	return a.T.Visit(key, typed.F_Visit[interface{}](f))
+}
+
+// adapter::Walk
+func (a *adapter) Walk(f walker.F) {
+	a.T.Walk(func(n typed.WalkerNode[interface{}, interface{}]) walker.Action { return f(n) })
+}
+
+/**********************************************************************
+ ** Conformance Tests
+ **********************************************************************/
+
+// Test_BSTTest_T
+func Test_BSTTest_T(t *testing.T) {
+	bsttest.RunT(t, func(fcmp cmp.F) bst.T { return newAdapter(fcmp) })
+}
+
+// Test_BSTTest_Finder
+func Test_BSTTest_Finder(t *testing.T) {
+	bsttest.RunFinder(t, func(fcmp cmp.F) bsttest.Finder { return newAdapter(fcmp) })
+}
+
+// Test_BSTTest_Visitor
+func Test_BSTTest_Visitor(t *testing.T) {
+	bsttest.RunVisitor(t, func(fcmp cmp.F) bsttest.Visitor { return newAdapter(fcmp) })
+}
+
+// Test_BSTTest_Walker
+func Test_BSTTest_Walker(t *testing.T) {
+	bsttest.RunWalker(t, func(fcmp cmp.F) bsttest.Walker { return newAdapter(fcmp) })
+}
@@ -0,0 +1,94 @@
+/*
+
+Package gsimple is a generics-native port of the simple reference
+implementation of an extensible Binary Search Tree, as defined in
+the go_bst package and sub-packages.
+
+Nodes store keys of type K and values of type V directly, instead
+of as interface{}, so non-pointer keys and values are not boxed,
+and keys are compared through a func(a, b K) int without
+interface dispatch.
+
+
+Standard BST Methods
+--------------------
+
+The standard BST methods of bst.T have been implemented, with
+typed keys and values:
+
+ * Empty
+ * ReplaceOrInsert
+ * Get
+ * Remove
+
+
+Extensible BST Methods
+----------------------
+
+Generic equivalents of the extensible methods have been implemented,
+using the call-back and node types of the typed package, and the
+Action and Result enums of the finder, visitor and walker packages:
+
+ * Find  (with typed.F_Find and typed.FinderNode)
+ * Visit (with typed.F_Visit)
+ * Walk  (with typed.F_Walk and typed.WalkerNode)
+
+Since the call-backs match those of typed.Tree, an algorithm written
+against one works with the other.  Algorithms written against the
+finder, visitor and walker packages port mechanically by changing
+the node and call-back types.
+
+
+Additional BST Methods
+----------------------
+
+The following additional BST methods have been implemented:
+
+ * Size
+ * Min
+ * Max
+
+
+Keys Not Found
+--------------
+
+Where the interface{} based API returns nil for a key or value that
+was not found, the zero value of K or V is returned instead.
+
+
+Leaning
+-------
+
+As with the simple package, this implementation uses a 'toggle'
+mechanism to decide if it should remove from the left or the right
+when both options are available.
+
+
+Effeciency
+----------
+
+As with the simple package, the following functions use recursion:
+
+ * ReplaceOrInsert
+ * Remove
+ * Visit
+ * Walk
+
+The remaining functions do not use recursion and can be
+considered efficient implementations.
+
+
+License
+-------
+
+This package is released under the MIT License.
+See included file 'LICENSE' for more details.
+
+
+Contributors
+------------
+
+David Farell <DavidPFarrell@yahoo.com>
+
+*/
+package gsimple
@@ -0,0 +1,61 @@
+package gsimple
+
+import "fmt"
+
+import (
+	"github.com/iNamik/go_bst/finder"
+	"github.com/iNamik/go_bst/typed"
+)
+
+// rnode
+type rnode[K, V any] struct {
+	n    *node[K, V]
+	fcmp func(a, b K) int
+}
+
+// rnode::Key
+func (r *rnode[K, V]) Key() K {
+	return r.n.key
+}
+
+// rnode::Value
+func (r *rnode[K, V]) Value() V {
+	return r.n.value
+}
+
+// rnode::HasLeft
+func (r *rnode[K, V]) HasLeft() bool {
+	return r.n.left != nil
+}
+
+// rnode::HasRight
+func (r *rnode[K, V]) HasRight() bool {
+	return r.n.right != nil
+}
+
+// rnode::Cmp
+func (r *rnode[K, V]) Cmp(a K, b K) int {
+	return r.fcmp(a, b)
+}
+
+// Find
+func (t *tree[K, V]) Find(f typed.F_Find[K, V]) (key K, value V, found bool) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	for h := t.root; h != nil; {
+		switch action := f(&rnode[K, V]{n: h, fcmp: t.fcmp}); action {
+		case finder.LEFT:
+			h = h.left
+		case finder.RIGHT:
+			h = h.right
+		case finder.FOUND:
+			return h.key, h.value, true
+		case finder.NOT_FOUND:
+			return
+		default:
+			panic(fmt.Sprintf("illegal find action '%s'", action))
+
+		}
+	}
+	return
+}
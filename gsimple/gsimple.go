@@ -0,0 +1,214 @@
+package gsimple
+
+//import . "github.com/iNamik/go_pkg/debug/assert"
+
+import (
+	"cmp"
+	"sync"
+)
+
+import (
+	"github.com/iNamik/go_bst/typed"
+	"github.com/iNamik/go_bst/visitor"
+)
+
+/**********************************************************************
+ ** Types & Interfaces
+ **********************************************************************/
+
+// T
+type T[K, V any] interface {
+	Empty() bool
+	Size() int
+	ReplaceOrInsert(key K, value V) (replaced bool)
+	Get(key K) (value V, found bool)
+	Remove(key K) (removed bool)
+	Find(f typed.F_Find[K, V]) (key K, value V, found bool)
+	Visit(key K, f typed.F_Visit[V]) (value V, result visitor.Result)
+	Walk(f typed.F_Walk[K, V])
+	Min() (key K, value V, found bool)
+	Max() (key K, value V, found bool)
+}
+
+// node
+type node[K, V any] struct {
+	key   K
+	value V
+	left  *node[K, V]
+	right *node[K, V]
+}
+
+// tree
+type tree[K, V any] struct {
+	mutex *sync.Mutex
+	root  *node[K, V]
+	fcmp  func(a, b K) int
+	left  bool // To randomize removal of nodes
+	size  int
+}
+
+/**********************************************************************
+ ** Public Functions
+ **********************************************************************/
+
+// New
+func New[K, V any](fcmp func(a, b K) int) T[K, V] {
+	return &tree[K, V]{mutex: &sync.Mutex{}, root: nil, fcmp: fcmp, left: true, size: 0}
+}
+
+// NewOrdered creates a tree ordered by cmp.Compare
+func NewOrdered[K cmp.Ordered, V any]() T[K, V] {
+	return New[K, V](cmp.Compare[K])
+}
+
+// tree:Empty
+func (t *tree[K, V]) Empty() bool {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return t.size == 0
+}
+
+// tree:Size
+func (t *tree[K, V]) Size() int {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return t.size
+}
+
+// tree:ReplaceOrInsert
+func (t *tree[K, V]) ReplaceOrInsert(key K, value V) bool {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	var replaced bool
+	t.root, replaced = replaceOrInsert(t.root, key, value, t.fcmp)
+	if !replaced {
+		t.size++
+	}
+	return replaced
+}
+
+// tree::Get
+func (t *tree[K, V]) Get(key K) (value V, found bool) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	h := get(t.root, key, t.fcmp)
+	if h != nil {
+		return h.value, true
+	}
+	return
+}
+
+// tree::Remove
+func (t *tree[K, V]) Remove(key K) bool {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	var removed bool
+	t.root, removed, t.left = remove(t.root, key, t.fcmp, t.left)
+	if removed {
+		t.size--
+	}
+	return removed
+}
+
+/**********************************************************************
+ ** Private Functions
+ **********************************************************************/
+
+// replaceOrInsert returns true if key was replaced, false if it was inserted into the tree
+func replaceOrInsert[K, V any](h *node[K, V], key K, value V, fcmp func(a, b K) int) (*node[K, V], bool) {
+	if h == nil {
+		return &node[K, V]{key: key, value: value}, false
+	}
+	replaced := true
+	switch c := fcmp(key, h.key); {
+	case c < 0:
+		h.left, replaced = replaceOrInsert(h.left, key, value, fcmp)
+	case c > 0:
+		h.right, replaced = replaceOrInsert(h.right, key, value, fcmp)
+	default:
+		h.value = value
+	}
+	return h, replaced
+}
+
+// get
+func get[K, V any](h *node[K, V], key K, fcmp func(a, b K) int) *node[K, V] {
+	for h != nil {
+		switch c := fcmp(key, h.key); {
+		case c < 0:
+			h = h.left
+		case c > 0:
+			h = h.right
+		default:
+			return h
+		}
+	}
+	return nil
+}
+
+// remove
+func remove[K, V any](h *node[K, V], key K, fcmp func(a, b K) int, left bool) (*node[K, V], bool, bool) {
+	removed := false
+	newLeft := left
+	if h != nil {
+		switch c := fcmp(key, h.key); {
+		case c < 0:
+			h.left, removed, newLeft = remove(h.left, key, fcmp, left)
+		case c > 0:
+			h.right, removed, newLeft = remove(h.right, key, fcmp, left)
+		default:
+			h, newLeft = removeNode(h, left)
+			return h, true, newLeft
+		}
+	}
+	return h, removed, newLeft
+}
+
+// removeNode returns the node that replaces h
+func removeNode[K, V any](h *node[K, V], left bool) (*node[K, V], bool) {
+	// If there are any children
+	if h.left != nil || h.right != nil {
+		var n *node[K, V] = nil // Replacement node
+
+		// If we want left or if there is no right
+		if h.left != nil && (left || h.right == nil) {
+			// If we have both left and right, then use right next time
+			left = !(h.right != nil)
+			// If there is no left.right node
+			if h.left.right == nil {
+				n = h.left
+			} else {
+				// Find parent of max(h.left)
+				var nParent *node[K, V] = h.left
+				for nParent.right.right != nil {
+					nParent = nParent.right
+				}
+				n = nParent.right
+				nParent.right = n.left
+				n.left = h.left
+			}
+			n.right = h.right
+
+			// We want right or there is no left
+		} else {
+			// If we have both left and right, then use left next time
+			left = (h.left != nil)
+			// If there is no right.left node
+			if h.right.left == nil {
+				n = h.right
+			} else {
+				// Find parent of min(h.right)
+				var nParent *node[K, V] = h.right
+				for nParent.left.left != nil {
+					nParent = nParent.left
+				}
+				n = nParent.left
+				nParent.left = n.right
+				n.right = h.right
+			}
+			n.left = h.left
+		}
+		return n, left
+	}
+	return nil, left
+}
@@ -0,0 +1,180 @@
+package gsimple
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+import (
+	"github.com/iNamik/go_bst/finder"
+	"github.com/iNamik/go_bst/typed"
+	"github.com/iNamik/go_bst/visitor"
+	"github.com/iNamik/go_bst/walker"
+)
+
+/**********************************************************************
+ ** Test Data
+ **********************************************************************/
+
+// SEED keeps the tests repeatable
+const SEED = 1
+
+// random
+var random = rand.New(rand.NewSource(SEED))
+
+/**********************************************************************
+ ** Helper Functions
+ **********************************************************************/
+
+// randomTree returns a tree with keys 0..n-1 inserted in random order, with value = key * 10
+func randomTree(n int) T[int, int] {
+	r := NewOrdered[int, int]()
+	for _, k := range random.Perm(n) {
+		r.ReplaceOrInsert(k, k*10)
+	}
+	return r
+}
+
+/**********************************************************************
+ ** Test Functions
+ **********************************************************************/
+
+// Test_New
+func Test_New(t *testing.T) {
+	r := New[string, int](strings.Compare)
+	if !r.Empty() {
+		t.Fatalf("Empty() returned false for a new tree")
+	}
+	for i, k := range []string{"b", "c", "a"} {
+		if r.ReplaceOrInsert(k, i) {
+			t.Fatalf("ReplaceOrInsert(%q) returned true for a new key", k)
+		}
+	}
+	if !r.ReplaceOrInsert("a", 3) {
+		t.Fatalf("ReplaceOrInsert(%q) returned false for an existing key", "a")
+	}
+	if size := r.Size(); size != 3 {
+		t.Fatalf("Size() returned %d instead of %d", size, 3)
+	}
+	if v, found := r.Get("a"); !found || v != 3 {
+		t.Fatalf("Get(%q) returned (%d, %v) instead of (%d, %v)", "a", v, found, 3, true)
+	}
+	if v, found := r.Get("d"); found || v != 0 {
+		t.Fatalf("Get(%q) returned (%d, %v) instead of (%d, %v)", "d", v, found, 0, false)
+	}
+	if !r.Remove("b") || r.Remove("b") {
+		t.Fatalf("Remove(%q) did not remove the key exactly once", "b")
+	}
+	if size := r.Size(); size != 2 {
+		t.Fatalf("Size() returned %d instead of %d", size, 2)
+	}
+	if k, v, found := r.Min(); k != "a" || v != 3 || !found {
+		t.Fatalf("Min() returned (%q, %d, %v)", k, v, found)
+	}
+	if k, v, found := r.Max(); k != "c" || v != 1 || !found {
+		t.Fatalf("Max() returned (%q, %d, %v)", k, v, found)
+	}
+}
+
+// Test_Find
+func Test_Find(t *testing.T) {
+	r := randomTree(100)
+	for _, key := range []int{-1, 0, 42, 99, 100} {
+		k, v, found := r.Find(func(n typed.FinderNode[int, int]) finder.Action {
+			switch c := n.Cmp(key, n.Key()); {
+			case c < 0:
+				return finder.LEFT
+			case c > 0:
+				return finder.RIGHT
+			}
+			return finder.FOUND
+		})
+		if found != (0 <= key && key < 100) {
+			t.Fatalf("Find(%d) returned found = %v", key, found)
+		}
+		if found && (k != key || v != key*10) {
+			t.Fatalf("Find(%d) returned (%d, %d) instead of (%d, %d)", key, k, v, key, key*10)
+		}
+		if !found && (k != 0 || v != 0) {
+			t.Fatalf("Find(%d) returned (%d, %d) instead of the zero values", key, k, v)
+		}
+	}
+}
+
+// Test_Visit
+func Test_Visit(t *testing.T) {
+	r := NewOrdered[string, int]()
+	// increment counts a key, starting at 1
+	increment := func(value int, found bool) (int, visitor.Action) {
+		if found {
+			return value + 1, visitor.REPLACE
+		}
+		return 1, visitor.INSERT
+	}
+	for _, k := range []string{"a", "b", "a", "c", "a", "b"} {
+		r.Visit(k, increment)
+	}
+	for k, count := range map[string]int{"a": 3, "b": 2, "c": 1} {
+		if v, found := r.Get(k); !found || v != count {
+			t.Fatalf("Get(%q) returned (%d, %v) instead of (%d, %v)", k, v, found, count, true)
+		}
+	}
+	value, result := r.Visit("b", func(value int, found bool) (int, visitor.Action) {
+		return 0, visitor.REMOVE
+	})
+	if value != 2 || result != visitor.REMOVED || r.Size() != 2 {
+		t.Fatalf("Visit(%q) returned (%d, %s) instead of (%d, %s)", "b", value, result, 2, visitor.REMOVED)
+	}
+}
+
+// Test_Walk walks the tree in order using LEFT and NEXT, then back using PREV
+func Test_Walk(t *testing.T) {
+	const SIZE = 1000
+	r := randomTree(SIZE)
+	i, forward := 0, true
+	r.Walk(func(n typed.WalkerNode[int, int]) walker.Action {
+		if n.HasLeft() && i == 0 && forward {
+			return walker.LEFT
+		}
+		if n.Key() != i || n.Value() != i*10 {
+			t.Fatalf("walked (%d, %d) instead of (%d, %d)", n.Key(), n.Value(), i, i*10)
+		}
+		if n.HasPrev() != (i > 0) || n.HasNext() != (i < SIZE-1) {
+			t.Fatalf("node %d has HasPrev() = %v, HasNext() = %v", i, n.HasPrev(), n.HasNext())
+		}
+		if forward && n.HasNext() {
+			i++
+			return walker.NEXT
+		}
+		forward = false
+		if n.HasPrev() {
+			i--
+			return walker.PREV
+		}
+		return walker.RETURN
+	})
+	if i != 0 || forward {
+		t.Fatalf("walk ended at key %d", i)
+	}
+}
+
+// Test_Remove removes every key, in random order, from a random tree
+func Test_Remove(t *testing.T) {
+	const SIZE = 1000
+	r := randomTree(SIZE)
+	for i, k := range random.Perm(SIZE) {
+		if !r.Remove(k) {
+			t.Fatalf("Remove(%d) returned false", k)
+		}
+		if _, found := r.Get(k); found {
+			t.Fatalf("Get(%d) found a removed key", k)
+		}
+		if size := r.Size(); size != SIZE-i-1 {
+			t.Fatalf("Size() returned %d instead of %d", size, SIZE-i-1)
+		}
+	}
+	if !r.Empty() {
+		t.Fatalf("Empty() returned false after removing every key")
+	}
+}
@@ -0,0 +1,43 @@
+package gsimple
+
+import . "github.com/iNamik/go_pkg/debug/assert"
+
+// Min
+func (t *tree[K, V]) Min() (key K, value V, found bool) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if t.root == nil {
+		return
+	}
+	h := min(t.root)
+	return h.key, h.value, true
+}
+
+// Max
+func (t *tree[K, V]) Max() (key K, value V, found bool) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if t.root == nil {
+		return
+	}
+	h := max(t.root)
+	return h.key, h.value, true
+}
+
+// min
+func min[K, V any](h *node[K, V]) *node[K, V] {
+	Assert(h != nil)
+	for h.left != nil {
+		h = h.left
+	}
+	return h
+}
+
+// max
+func max[K, V any](h *node[K, V]) *node[K, V] {
+	Assert(h != nil)
+	for h.right != nil {
+		h = h.right
+	}
+	return h
+}
@@ -0,0 +1,62 @@
+package gsimple
+
+import "fmt"
+
+import (
+	"github.com/iNamik/go_bst/typed"
+	"github.com/iNamik/go_bst/visitor"
+)
+
+// tree::Visit
+func (t *tree[K, V]) Visit(key K, f typed.F_Visit[V]) (value V, result visitor.Result) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.root, value, result, t.left = visit(t.root, key, t.fcmp, f, t.left)
+	if result == visitor.INSERTED {
+		t.size++
+	} else if result == visitor.REMOVED {
+		t.size--
+	}
+	return value, result
+}
+
+// visit
+func visit[K, V any](h *node[K, V], key K, fcmp func(a, b K) int, f typed.F_Visit[V], left bool) (_ *node[K, V], value V, result visitor.Result, _ bool) {
+	if h == nil {
+		var zero V
+		var action visitor.Action
+		value, action = f(zero, false)
+		switch action {
+		case visitor.INSERT:
+			return &node[K, V]{key: key, value: value}, value, visitor.INSERTED, left
+		case visitor.GET:
+			return nil, zero, visitor.NOT_FOUND, left
+		default:
+			panic(fmt.Sprintf("illegal action '%s' when visiting non-found key", action))
+		}
+	}
+	switch c := fcmp(key, h.key); {
+	case c < 0:
+		h.left, value, result, left = visit(h.left, key, fcmp, f, left)
+	case c > 0:
+		h.right, value, result, left = visit(h.right, key, fcmp, f, left)
+	default:
+		var action visitor.Action
+		value, action = f(h.value, true)
+		switch action {
+		case visitor.GET:
+			value = h.value
+			result = visitor.FOUND
+		case visitor.REPLACE:
+			h.value = value
+			result = visitor.REPLACED
+		case visitor.REMOVE:
+			value = h.value
+			h, left = removeNode(h, left)
+			result = visitor.REMOVED
+		default:
+			panic(fmt.Sprintf("illegal action '%s' when visiting found key", action))
+		}
+	}
+	return h, value, result, left
+}
@@ -0,0 +1,83 @@
+/*
+
+Package linked provides an extensible Binary Search Tree, as defined
+in the go_bst package and sub-packages, whose nodes link to their
+parent and to their in-order neighbors, as well as to their children.
+
+
+Standard BST Methods
+--------------------
+
+All of the standard BST methods required to satisfy the
+bst.T interface have been implemented:
+
+ * Empty
+ * ReplaceOrInsert
+ * Get
+ * Remove
+
+
+Extensible BST Methods
+----------------------
+
+All of the extensible interfaces have been implemented:
+
+ * Find  (see finder.T)
+ * Visit (see visitor.T)
+ * Walk  (see walker.T)
+
+
+Additional BST Methods
+----------------------
+
+The following additional BST methods have been implemented:
+
+ * Size     (see bst.I_Size)
+ * Min      (see finder.I_Min)
+ * Max      (see finder.I_Max)
+ * Validate (see bst.I_Validate)
+
+
+Leaning
+-------
+
+As with the simple package, this implementation uses a 'toggle'
+mechanism to decide if it should remove from the left or the right
+when both options are available.
+
+
+Efficiency
+----------
+
+Unlike the simple package, no function uses recursion, so a
+degenerate (e.g. sorted) insertion order costs time, but never
+stack space:
+
+ * ReplaceOrInsert, Remove and Visit search down the tree once,
+   then link or unlink a node in O(1)
+ * Walk follows a single pointer for every action, so LEFT, RIGHT,
+   PARENT, PREV and NEXT are each O(1)
+ * Min and Max are O(1)
+
+Level is tracked as Walk moves up and down the tree.  After PREV or
+NEXT, it is counted on demand, in O(height), by following parents.
+
+The extra links cost three pointers per node, and rule out sharing
+nodes between trees, so there is no equivalent of the O(1) Clone
+and Snapshot of the simple package.
+
+
+License
+-------
+
+This package is released under the MIT License.
+See included file 'LICENSE' for more details.
+
+
+Contributors
+------------
+
+David Farell <DavidPFarrell@yahoo.com>
+
+*/
+package linked
@@ -0,0 +1,203 @@
+package linked
+
+import (
+	"sync"
+)
+
+import (
+	"github.com/iNamik/go_bst"
+	"github.com/iNamik/go_bst/finder"
+	"github.com/iNamik/go_bst/visitor"
+	"github.com/iNamik/go_bst/walker"
+	"github.com/iNamik/go_cmp"
+)
+
+/**********************************************************************
+ ** Types & Interfaces
+ **********************************************************************/
+
+// T
+type T interface {
+	bst.T
+	finder.I
+	visitor.I
+	walker.I
+	bst.I_Size
+	bst.I_Validate
+	finder.I_Min
+	finder.I_Max
+}
+
+// node links to its parent, and to its in-order neighbors (prev and next),
+// as well as to its children
+type node struct {
+	key    interface{}
+	value  interface{}
+	left   *node
+	right  *node
+	parent *node
+	prev   *node
+	next   *node
+}
+
+// tree
+type tree struct {
+	mutex sync.RWMutex
+	root  *node
+	first *node // Node with the minimum key
+	last  *node // Node with the maximum key
+	fcmp  cmp.F
+	left  bool // Remove from the left next time both children are present
+	size  int
+}
+
+/**********************************************************************
+ ** Public Functions
+ **********************************************************************/
+
+// New
+func New(fcmp cmp.F) T {
+	return &tree{root: nil, fcmp: fcmp, left: true, size: 0}
+}
+
+// tree:Empty
+func (t *tree) Empty() bool {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+	return t.size == 0
+}
+
+// tree:Size
+func (t *tree) Size() int {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+	return t.size
+}
+
+// tree:ReplaceOrInsert
+func (t *tree) ReplaceOrInsert(key interface{}, value interface{}) bool {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	h, p, c := t.search(key)
+	if h != nil {
+		h.value = value
+		return true
+	}
+	t.insert(p, c, key, value)
+	return false
+}
+
+// tree::Get
+func (t *tree) Get(key interface{}) (interface{}, bool) {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+	if h, _, _ := t.search(key); h != nil {
+		return h.value, true
+	}
+	return nil, false
+}
+
+// tree::Remove
+func (t *tree) Remove(key interface{}) bool {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if h, _, _ := t.search(key); h != nil {
+		t.removeNode(h)
+		return true
+	}
+	return false
+}
+
+/**********************************************************************
+ ** Private Functions
+ **********************************************************************/
+
+// tree::search returns the node with key, if found.  Otherwise it returns
+// the node that would be key's parent (nil for an empty tree), along
+// with the result of comparing key to the parent's key.
+func (t *tree) search(key interface{}) (h *node, p *node, c int) {
+	for h = t.root; h != nil; {
+		switch c = t.fcmp(key, h.key); c {
+		case cmp.LT:
+			p, h = h, h.left
+		case cmp.GT:
+			p, h = h, h.right
+		default:
+			return h, p, c
+		}
+	}
+	return nil, p, c
+}
+
+// tree::insert adds key as a child of p, on the side given by c (see search),
+// linking it between its in-order neighbors
+func (t *tree) insert(p *node, c int, key interface{}, value interface{}) *node {
+	n := &node{key: key, value: value, parent: p}
+	switch {
+	case p == nil:
+		t.root = n
+	case c == cmp.LT:
+		// n immediately precedes p
+		p.left = n
+		n.prev, n.next = p.prev, p
+	default:
+		// n immediately follows p
+		p.right = n
+		n.prev, n.next = p, p.next
+	}
+	if n.prev != nil {
+		n.prev.next = n
+	} else {
+		t.first = n
+	}
+	if n.next != nil {
+		n.next.prev = n
+	} else {
+		t.last = n
+	}
+	t.size++
+	return n
+}
+
+// tree::removeNode removes h's key from the tree.  If h has two children,
+// its in-order neighbor (alternating between prev and next) moves into h,
+// and the neighbor's node, which has at most one child, is unlinked instead.
+func (t *tree) removeNode(h *node) {
+	if h.left != nil && h.right != nil {
+		d := h.next
+		if t.left {
+			d = h.prev
+		}
+		t.left = !t.left
+		h.key, h.value = d.key, d.value
+		h = d
+	}
+	// Replace h with its only child, if any
+	child := h.left
+	if child == nil {
+		child = h.right
+	}
+	if child != nil {
+		child.parent = h.parent
+	}
+	switch {
+	case h.parent == nil:
+		t.root = child
+	case h.parent.left == h:
+		h.parent.left = child
+	default:
+		h.parent.right = child
+	}
+	// Unlink h from its in-order neighbors
+	if h.prev != nil {
+		h.prev.next = h.next
+	} else {
+		t.first = h.next
+	}
+	if h.next != nil {
+		h.next.prev = h.prev
+	} else {
+		t.last = h.prev
+	}
+	t.size--
+}
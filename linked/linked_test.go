@@ -0,0 +1,162 @@
+package linked
+
+import (
+	"math/rand"
+	"testing"
+)
+
+import (
+	"github.com/iNamik/go_bst/visitor"
+	"github.com/iNamik/go_bst/walker"
+	"github.com/iNamik/go_cmp"
+)
+
+/**********************************************************************
+ ** Assert Functions
+ **********************************************************************/
+
+// assertValidate
+func assertValidate(r T, t *testing.T) {
+	if err := r.Validate(); err != nil {
+		t.Fatalf("Validate() returned '%v'", err)
+	}
+}
+
+// assertKeys confirms that the tree holds exactly the keys from..to,
+// walking forward from the minimum with NEXT
+func assertKeys(r T, from int, to int, t *testing.T) {
+	i := from
+	walker.ForeachMin(r, func(key interface{}, value interface{}) {
+		if key != i || value != i {
+			t.Fatalf("walk visited (%v, %v) instead of (%d, %d)", key, value, i, i)
+		}
+		i++
+	})
+	if i != to+1 {
+		t.Fatalf("walk ended at '%d' instead of '%d'", i-1, to)
+	}
+}
+
+/**********************************************************************
+ ** Test Functions
+ **********************************************************************/
+
+// Test_Degenerate builds a tree that is a single long chain, which would
+// need one stack frame per level with a recursive implementation
+func Test_Degenerate(t *testing.T) {
+	const COUNT = 10000
+	r := New(cmp.F_int)
+	for i := 0; i < COUNT; i++ {
+		r.ReplaceOrInsert(i, i)
+	}
+	assertValidate(r, t)
+	assertKeys(r, 0, COUNT-1, t)
+	// Walk down to the bottom of the chain, then all the way back up
+	depth := 0
+	r.Walk(func(n walker.Node) walker.Action {
+		if n.HasRight() && depth == 0 {
+			return walker.RIGHT
+		}
+		if depth == 0 {
+			if n.Level() != COUNT {
+				t.Fatalf("deepest node has Level() %d instead of %d", n.Level(), COUNT)
+			}
+			depth = n.Level()
+		}
+		if n.HasParent() {
+			return walker.PARENT
+		}
+		if n.Key() != 0 || n.Level() != 1 {
+			t.Fatalf("walked up to (%v, %d) instead of (0, 1)", n.Key(), n.Level())
+		}
+		return walker.RETURN
+	})
+	for i := COUNT - 1; i >= 0; i -= 2 {
+		if _, found := visitor.GetAndRemove(r, i); found != true {
+			t.Fatalf("GetAndRemove(%d) did not find the key", i)
+		}
+	}
+	assertValidate(r, t)
+	if r.Size() != COUNT/2 {
+		t.Fatalf("Size() returned %d instead of %d", r.Size(), COUNT/2)
+	}
+}
+
+// Test_Level confirms that Level is counted correctly after PREV and NEXT
+func Test_Level(t *testing.T) {
+	r := New(cmp.F_int)
+	for _, k := range []int{4, 2, 6, 1, 3, 5, 7} {
+		r.ReplaceOrInsert(k, k)
+	}
+	levels := map[int]int{4: 1, 2: 2, 6: 2, 1: 3, 3: 3, 5: 3, 7: 3}
+	haveMin := false
+	r.Walk(func(n walker.Node) walker.Action {
+		if n.HasLeft() && haveMin == false {
+			return walker.LEFT
+		}
+		haveMin = true
+		if n.Level() != levels[n.Key().(int)] {
+			t.Fatalf("node '%v' has Level() %d instead of %d", n.Key(), n.Level(), levels[n.Key().(int)])
+		}
+		if n.HasNext() {
+			return walker.NEXT
+		}
+		return walker.RETURN
+	})
+}
+
+// Test_Random applies random inserts and removes, validating the
+// parent and neighbor links after each
+func Test_Random(t *testing.T) {
+	const COUNT = 2000
+	rnd := rand.New(rand.NewSource(1))
+	r := New(cmp.F_int)
+	m := map[int]bool{}
+	for i := 0; i < COUNT; i++ {
+		k := rnd.Intn(COUNT / 4)
+		if rnd.Intn(3) == 0 {
+			if r.Remove(k) != m[k] {
+				t.Fatalf("Remove(%d) returned %v", k, !m[k])
+			}
+			delete(m, k)
+		} else {
+			if r.ReplaceOrInsert(k, k) != m[k] {
+				t.Fatalf("ReplaceOrInsert(%d) returned %v", k, !m[k])
+			}
+			m[k] = true
+		}
+		assertValidate(r, t)
+	}
+	if r.Size() != len(m) {
+		t.Fatalf("Size() returned %d instead of %d", r.Size(), len(m))
+	}
+	for r.Empty() == false {
+		k, _, _ := r.Min()
+		r.Remove(k)
+		k, _, found := r.Max()
+		if found {
+			r.Remove(k)
+		}
+		assertValidate(r, t)
+	}
+}
+
+// Test_Validate_Broken confirms that Validate reports broken links
+func Test_Validate_Broken(t *testing.T) {
+	r := New(cmp.F_int)
+	for _, k := range []int{2, 1, 3} {
+		r.ReplaceOrInsert(k, k)
+	}
+	tr := r.(*tree)
+	tr.root.left.parent = nil
+	if r.Validate() == nil {
+		t.Fatalf("Validate() did not report a broken parent link")
+	}
+	tr.root.left.parent = tr.root
+	tr.root.right.prev = nil
+	if r.Validate() == nil {
+		t.Fatalf("Validate() did not report a broken prev link")
+	}
+	tr.root.right.prev = tr.root
+	assertValidate(r, t)
+}
@@ -0,0 +1,21 @@
+package linked
+
+// tree::Min is O(1), as the tree tracks its first node
+func (t *tree) Min() (interface{}, interface{}, bool) {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+	if t.first == nil {
+		return nil, nil, false
+	}
+	return t.first.key, t.first.value, true
+}
+
+// tree::Max is O(1), as the tree tracks its last node
+func (t *tree) Max() (interface{}, interface{}, bool) {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+	if t.last == nil {
+		return nil, nil, false
+	}
+	return t.last.key, t.last.value, true
+}
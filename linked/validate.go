@@ -0,0 +1,73 @@
+package linked
+
+import (
+	"fmt"
+)
+
+import (
+	"github.com/iNamik/go_cmp"
+)
+
+// tree::Validate confirms, without recursion, that each child links back
+// to its parent, that an in-order traversal of the children matches the
+// prev and next links, with keys strictly ordered, and that the size of
+// the tree matches the number of nodes
+func (t *tree) Validate() error {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+	if t.root == nil {
+		if t.first != nil || t.last != nil || t.size != 0 {
+			return fmt.Errorf("empty tree has size %d, first or last node", t.size)
+		}
+		return nil
+	}
+	if t.root.parent != nil {
+		return fmt.Errorf("root (key '%v') has a parent", t.root.key)
+	}
+	var prev *node
+	count := 0
+	for h := leftmost(t.root); h != nil; h = successor(h) {
+		if count++; count > t.size {
+			return fmt.Errorf("tree has size %d but contains more nodes, or a cycle", t.size)
+		}
+		for _, child := range []*node{h.left, h.right} {
+			if child != nil && child.parent != h {
+				return fmt.Errorf("node (key '%v') does not link back to its parent (key '%v')", child.key, h.key)
+			}
+		}
+		if h.prev != prev || (prev == nil && t.first != h) || (prev != nil && prev.next != h) {
+			return fmt.Errorf("node (key '%v') is not linked to its in-order neighbors", h.key)
+		}
+		if prev != nil && t.fcmp(prev.key, h.key) != cmp.LT {
+			return fmt.Errorf("node (key '%v') is not greater than its predecessor (key '%v')", h.key, prev.key)
+		}
+		prev = h
+	}
+	if prev.next != nil || t.last != prev {
+		return fmt.Errorf("node (key '%v') is the last node, but is not linked as the last node", prev.key)
+	}
+	if count != t.size {
+		return fmt.Errorf("tree has size %d but contains %d nodes", t.size, count)
+	}
+	return nil
+}
+
+// leftmost returns the node with the least key in h's subtree
+func leftmost(h *node) *node {
+	for h.left != nil {
+		h = h.left
+	}
+	return h
+}
+
+// successor finds h's in-order successor using only child and parent
+// links, so that it can be checked against h.next
+func successor(h *node) *node {
+	if h.right != nil {
+		return leftmost(h.right)
+	}
+	for h.parent != nil && h.parent.right == h {
+		h = h.parent
+	}
+	return h.parent
+}
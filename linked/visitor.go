@@ -0,0 +1,41 @@
+package linked
+
+import "fmt"
+
+import (
+	"github.com/iNamik/go_bst/visitor"
+)
+
+// tree::Visit searches for key once, then acts on the node found,
+// or on the insertion point, without recursion
+func (t *tree) Visit(key interface{}, f visitor.F) (interface{}, visitor.Result) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	h, p, c := t.search(key)
+	if h == nil {
+		value, action := f(nil, false)
+		switch action {
+		case visitor.INSERT:
+			t.insert(p, c, key, value)
+			return value, visitor.INSERTED
+		case visitor.GET:
+			return nil, visitor.NOT_FOUND
+		default:
+			panic(fmt.Sprintf("illegal action '%s' when visiting non-found key", action))
+		}
+	}
+	value, action := f(h.value, true)
+	switch action {
+	case visitor.GET:
+		return h.value, visitor.FOUND
+	case visitor.REPLACE:
+		h.value = value
+		return value, visitor.REPLACED
+	case visitor.REMOVE:
+		value = h.value
+		t.removeNode(h)
+		return value, visitor.REMOVED
+	default:
+		panic(fmt.Sprintf("illegal action '%s' when visiting found key", action))
+	}
+}
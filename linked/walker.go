@@ -0,0 +1,126 @@
+package linked
+
+import "fmt"
+
+import (
+	"github.com/iNamik/go_bst/walker"
+	"github.com/iNamik/go_cmp"
+)
+
+// wnode
+type wnode struct {
+	n     *node
+	fcmp  cmp.F
+	level int // 0 = not yet known
+}
+
+// wnode::Key
+func (w *wnode) Key() interface{} {
+	return w.n.key
+}
+
+// wnode::Value
+func (w *wnode) Value() interface{} {
+	return w.n.value
+}
+
+// wnode::Cmp
+func (w *wnode) Cmp(a interface{}, b interface{}) int {
+	return w.fcmp(a, b)
+}
+
+// wnode::Level is only tracked across LEFT, RIGHT and PARENT.
+// After PREV or NEXT it is counted, on demand, by following parents.
+func (w *wnode) Level() int {
+	if w.level == 0 {
+		for h := w.n; h != nil; h = h.parent {
+			w.level++
+		}
+	}
+	return w.level
+}
+
+// wnode::HasPrev
+func (w *wnode) HasPrev() bool {
+	return w.n.prev != nil
+}
+
+// wnode::HasNext
+func (w *wnode) HasNext() bool {
+	return w.n.next != nil
+}
+
+// wnode::HasLeft
+func (w *wnode) HasLeft() bool {
+	return w.n.left != nil
+}
+
+// wnode::HasRight
+func (w *wnode) HasRight() bool {
+	return w.n.right != nil
+}
+
+// wnode::HasParent
+func (w *wnode) HasParent() bool {
+	return w.n.parent != nil
+}
+
+// tree::Walk follows a single pointer for every action, without recursion
+func (t *tree) Walk(f walker.F) {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+	// We don't walk an empty tree
+	if t.root == nil {
+		return
+	}
+	h, level := t.root, 1
+	for {
+		switch action := f(&wnode{n: h, fcmp: t.fcmp, level: level}); action {
+		case walker.LEFT:
+			if h.left == nil {
+				panic("cannot walk left when hasLeft() == false")
+			}
+			h, level = h.left, down(level)
+		case walker.RIGHT:
+			if h.right == nil {
+				panic("cannot walk right when hasRight() == false")
+			}
+			h, level = h.right, down(level)
+		case walker.PARENT:
+			if h.parent == nil {
+				panic("cannot walk parent when hasParent() == false")
+			}
+			h, level = h.parent, up(level)
+		case walker.PREV:
+			if h.prev == nil {
+				panic("cannot walk prev when hasPrev() == false")
+			}
+			h, level = h.prev, 0
+		case walker.NEXT:
+			if h.next == nil {
+				panic("cannot walk next when hasNext() == false")
+			}
+			h, level = h.next, 0
+		case walker.RETURN:
+			return
+		default:
+			panic(fmt.Sprintf("illegal walk action '%s'", action))
+		}
+	}
+}
+
+// down returns the level of a child, keeping an unknown level unknown
+func down(level int) int {
+	if level == 0 {
+		return 0
+	}
+	return level + 1
+}
+
+// up returns the level of a parent, keeping an unknown level unknown
+func up(level int) int {
+	if level == 0 {
+		return 0
+	}
+	return level - 1
+}
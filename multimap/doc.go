@@ -0,0 +1,53 @@
+/*
+
+Package multimap provides an ordered multimap, stored in any tree
+that implements bst.T, visitor.I and walker.I, such as the trees of
+the simple, avl and redblack packages.
+
+Each key holds any number of values, in insertion order.  A multimap
+is created with the function that creates its tree:
+
+	m := multimap.New(cmp.F_int, func(fcmp cmp.F) multimap.I { return avl.New(fcmp) })
+
+
+Multimap Methods
+----------------
+
+ * Insert     (appends value to the values already under key)
+ * Get        (returns every value under key, in insertion order)
+ * Contains
+ * RemoveOne  (removes the oldest value under key)
+ * RemoveAll  (removes key, along with every value under it)
+ * Empty
+ * Size       (counts (key, value) entries, not keys)
+ * ForeachMin (visits each (key, value) entry)
+ * ForeachMax (visits each (key, value) entry, in reverse)
+
+
+Storage
+-------
+
+The values of each key are stored in the tree as a []interface{}.
+Stored slices are never modified; each change replaces the slice
+via the tree's Visit method, so slices returned by Get are never
+affected by later changes, and the multimap is as safe for
+concurrent use as its tree.
+
+Since each change copies a key's values, Insert and RemoveOne run
+in O(log n + k), where k is the number of values under the key.
+
+
+License
+-------
+
+This package is released under the MIT License.
+See included file 'LICENSE' for more details.
+
+
+Contributors
+------------
+
+David Farell <DavidPFarrell@yahoo.com>
+
+*/
+package multimap
@@ -0,0 +1,160 @@
+package multimap
+
+import (
+	"sync/atomic"
+)
+
+import (
+	"github.com/iNamik/go_bst"
+	"github.com/iNamik/go_bst/visitor"
+	"github.com/iNamik/go_bst/walker"
+	"github.com/iNamik/go_cmp"
+)
+
+/**********************************************************************
+ ** Types & Interfaces
+ **********************************************************************/
+
+// I specifies the tree a multimap is stored in
+type I interface {
+	bst.T
+	visitor.I
+	walker.I
+}
+
+// F_New creates a new, empty tree whose keys are ordered by fcmp
+type F_New func(fcmp cmp.F) I
+
+// T
+type T interface {
+	// Insert adds value under key, after any values already under key
+	Insert(key interface{}, value interface{})
+	// Get returns the values under key, in insertion order
+	Get(key interface{}) (values []interface{}, found bool)
+	// Contains returns true if there are any values under key
+	Contains(key interface{}) bool
+	// RemoveOne removes the oldest value under key
+	RemoveOne(key interface{}) (value interface{}, removed bool)
+	// RemoveAll removes every value under key, returning them in insertion order
+	RemoveAll(key interface{}) (values []interface{}, removed bool)
+	// Empty returns true if the multimap has no entries
+	Empty() bool
+	// Size returns the number of (key, value) entries, not the number of keys
+	Size() int
+	// ForeachMin visits every (key, value) entry, in ascending key order,
+	// and in insertion order for the values of each key
+	ForeachMin(f walker.F_Visit)
+	// ForeachMax visits every (key, value) entry, in exactly the reverse order of ForeachMin
+	ForeachMax(f walker.F_Visit)
+	// Tree returns the tree the multimap is stored in.
+	// Each key's values are stored as a []interface{}, which must not be modified.
+	Tree() I
+}
+
+// multimap
+type multimap struct {
+	i    I
+	size int64 // Number of entries, changed atomically as the tree can be used concurrently
+}
+
+/**********************************************************************
+ ** Public Functions
+ **********************************************************************/
+
+// New creates an empty multimap, stored in a tree created by fnew
+func New(fcmp cmp.F, fnew F_New) T {
+	return &multimap{i: fnew(fcmp), size: 0}
+}
+
+// multimap::Insert never modifies a stored slice, so that slices
+// returned by Get are not affected by later changes
+func (m *multimap) Insert(key interface{}, value interface{}) {
+	m.i.Visit(key, func(values interface{}, found bool) (interface{}, visitor.Action) {
+		if found {
+			vs := values.([]interface{})
+			// The full slice expression forces append to copy
+			return append(vs[:len(vs):len(vs)], value), visitor.REPLACE
+		}
+		return []interface{}{value}, visitor.INSERT
+	})
+	atomic.AddInt64(&m.size, 1)
+}
+
+// multimap::Get
+func (m *multimap) Get(key interface{}) ([]interface{}, bool) {
+	values, found := m.i.Get(key)
+	if !found {
+		return nil, false
+	}
+	return append([]interface{}(nil), values.([]interface{})...), true
+}
+
+// multimap::Contains
+func (m *multimap) Contains(key interface{}) bool {
+	_, found := m.i.Get(key)
+	return found
+}
+
+// multimap::RemoveOne
+func (m *multimap) RemoveOne(key interface{}) (value interface{}, removed bool) {
+	m.i.Visit(key, func(values interface{}, found bool) (interface{}, visitor.Action) {
+		if !found {
+			return nil, visitor.GET
+		}
+		vs := values.([]interface{})
+		value, removed = vs[0], true
+		if len(vs) == 1 {
+			return nil, visitor.REMOVE
+		}
+		return append([]interface{}(nil), vs[1:]...), visitor.REPLACE
+	})
+	if removed {
+		atomic.AddInt64(&m.size, -1)
+	}
+	return value, removed
+}
+
+// multimap::RemoveAll
+func (m *multimap) RemoveAll(key interface{}) ([]interface{}, bool) {
+	values, removed := visitor.GetAndRemove(m.i, key)
+	if !removed {
+		return nil, false
+	}
+	vs := values.([]interface{})
+	atomic.AddInt64(&m.size, -int64(len(vs)))
+	return vs, true
+}
+
+// multimap::Empty
+func (m *multimap) Empty() bool {
+	return m.Size() == 0
+}
+
+// multimap::Size
+func (m *multimap) Size() int {
+	return int(atomic.LoadInt64(&m.size))
+}
+
+// multimap::ForeachMin
+func (m *multimap) ForeachMin(f walker.F_Visit) {
+	walker.ForeachMin(m.i, func(key interface{}, values interface{}) {
+		for _, value := range values.([]interface{}) {
+			f(key, value)
+		}
+	})
+}
+
+// multimap::ForeachMax
+func (m *multimap) ForeachMax(f walker.F_Visit) {
+	walker.ForeachMax(m.i, func(key interface{}, values interface{}) {
+		vs := values.([]interface{})
+		for i := len(vs) - 1; i >= 0; i-- {
+			f(key, vs[i])
+		}
+	})
+}
+
+// multimap::Tree
+func (m *multimap) Tree() I {
+	return m.i
+}
@@ -0,0 +1,164 @@
+package multimap
+
+import (
+	"sync"
+	"testing"
+)
+
+import (
+	"github.com/iNamik/go_bst/avl"
+	"github.com/iNamik/go_bst/simple"
+	"github.com/iNamik/go_cmp"
+)
+
+/**********************************************************************
+ ** Test Data
+ **********************************************************************/
+
+// factories are the trees that the tests store multimaps in
+var factories = map[string]F_New{
+	"simple": func(fcmp cmp.F) I { return simple.New(fcmp) },
+	"avl":    func(fcmp cmp.F) I { return avl.New(fcmp) },
+}
+
+/**********************************************************************
+ ** Assert Functions
+ **********************************************************************/
+
+// assertGet confirms that key holds exactly values, in order
+func assertGet(m T, key int, values []interface{}, t *testing.T) {
+	values_, found := m.Get(key)
+	if found != (values != nil) || len(values_) != len(values) {
+		t.Fatalf("Get(%d) returned (%v, %v) instead of %v", key, values_, found, values)
+	}
+	for i := range values {
+		if values_[i] != values[i] {
+			t.Fatalf("Get(%d) returned %v instead of %v", key, values_, values)
+		}
+	}
+}
+
+/**********************************************************************
+ ** Test Functions
+ **********************************************************************/
+
+// Test_Multimap
+func Test_Multimap(t *testing.T) {
+	for name, fnew := range factories {
+		t.Run(name, func(t *testing.T) {
+			m := New(cmp.F_int, fnew)
+			if !m.Empty() {
+				t.Fatalf("Empty() returned false for a new multimap")
+			}
+			m.Insert(1, "a")
+			m.Insert(2, "x")
+			m.Insert(1, "b")
+			m.Insert(1, "c")
+			assertGet(m, 1, []interface{}{"a", "b", "c"}, t)
+			assertGet(m, 2, []interface{}{"x"}, t)
+			assertGet(m, 3, nil, t)
+			if m.Size() != 4 {
+				t.Fatalf("Size() returned %d instead of %d", m.Size(), 4)
+			}
+			if !m.Contains(2) || m.Contains(3) {
+				t.Fatalf("Contains() returned the wrong result")
+			}
+		})
+	}
+}
+
+// Test_RemoveOne
+func Test_RemoveOne(t *testing.T) {
+	m := New(cmp.F_int, factories["simple"])
+	m.Insert(1, "a")
+	m.Insert(1, "b")
+	for _, expected := range []string{"a", "b"} {
+		if value, removed := m.RemoveOne(1); value != expected || !removed {
+			t.Fatalf("RemoveOne(1) returned (%v, %v) instead of (%v, %v)", value, removed, expected, true)
+		}
+	}
+	if value, removed := m.RemoveOne(1); value != nil || removed {
+		t.Fatalf("RemoveOne(1) returned (%v, %v) for a removed key", value, removed)
+	}
+	if m.Contains(1) || !m.Empty() {
+		t.Fatalf("RemoveOne() of the last value did not remove the key")
+	}
+}
+
+// Test_RemoveAll
+func Test_RemoveAll(t *testing.T) {
+	m := New(cmp.F_int, factories["simple"])
+	m.Insert(1, "a")
+	m.Insert(1, "b")
+	m.Insert(2, "x")
+	values, removed := m.RemoveAll(1)
+	if !removed || len(values) != 2 || values[0] != "a" || values[1] != "b" {
+		t.Fatalf("RemoveAll(1) returned (%v, %v)", values, removed)
+	}
+	if values, removed := m.RemoveAll(1); values != nil || removed {
+		t.Fatalf("RemoveAll(1) returned (%v, %v) for a removed key", values, removed)
+	}
+	if m.Size() != 1 {
+		t.Fatalf("Size() returned %d instead of %d", m.Size(), 1)
+	}
+}
+
+// Test_Foreach
+func Test_Foreach(t *testing.T) {
+	m := New(cmp.F_int, factories["avl"])
+	for _, e := range [][2]interface{}{{2, "c"}, {1, "a"}, {2, "d"}, {1, "b"}, {3, "e"}} {
+		m.Insert(e[0], e[1])
+	}
+	var entries []string
+	m.ForeachMin(func(key interface{}, value interface{}) {
+		entries = append(entries, value.(string))
+	})
+	if len(entries) != 5 || entries[0] != "a" || entries[1] != "b" || entries[2] != "c" || entries[3] != "d" || entries[4] != "e" {
+		t.Fatalf("ForeachMin() visited %v", entries)
+	}
+	entries = entries[:0]
+	m.ForeachMax(func(key interface{}, value interface{}) {
+		entries = append(entries, value.(string))
+	})
+	if len(entries) != 5 || entries[0] != "e" || entries[1] != "d" || entries[2] != "c" || entries[3] != "b" || entries[4] != "a" {
+		t.Fatalf("ForeachMax() visited %v", entries)
+	}
+}
+
+// Test_Get_Unaffected confirms that slices returned by Get do not change
+func Test_Get_Unaffected(t *testing.T) {
+	m := New(cmp.F_int, factories["simple"])
+	m.Insert(1, "a")
+	values, _ := m.Get(1)
+	values[0] = "z"
+	m.Insert(1, "b")
+	m.RemoveOne(1)
+	assertGet(m, 1, []interface{}{"b"}, t)
+}
+
+// Test_Concurrent inserts and removes from several goroutines.
+// Run with -race to confirm that stored slices are never modified.
+func Test_Concurrent(t *testing.T) {
+	const COUNT = 1000
+	m := New(cmp.F_int, factories["simple"])
+	wg := &sync.WaitGroup{}
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < COUNT; j++ {
+				m.Insert(j%10, j)
+				m.Get(j % 10)
+				if j%2 == 0 {
+					m.RemoveOne(j % 10)
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+	size := 0
+	m.ForeachMin(func(_ interface{}, _ interface{}) { size++ })
+	if size != m.Size() || size != 4*COUNT/2 {
+		t.Fatalf("Size() returned %d, but there are %d entries instead of %d", m.Size(), size, 4*COUNT/2)
+	}
+}
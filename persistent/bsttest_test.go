@@ -0,0 +1,69 @@
+package persistent
+
+import (
+	"testing"
+)
+
+import (
+	"github.com/iNamik/go_bst"
+	"github.com/iNamik/go_bst/bsttest"
+	"github.com/iNamik/go_bst/visitor"
+	"github.com/iNamik/go_cmp"
+)
+
+/**********************************************************************
+ ** Adapter
+ **********************************************************************/
+
+// adapter exposes the latest version of a persistent tree through
+// the mutable API, so that it can be run against the conformance suite
+type adapter struct {
+	T
+}
+
+// newAdapter
+func newAdapter(fcmp cmp.F) *adapter {
+	return &adapter{New(fcmp)}
+}
+
+// adapter::ReplaceOrInsert
+func (a *adapter) ReplaceOrInsert(key interface{}, value interface{}) (replaced bool) {
+	a.T, replaced = a.T.ReplaceOrInsert(key, value)
+	return
+}
+
+// adapter::Remove
+func (a *adapter) Remove(key interface{}) (removed bool) {
+	a.T, removed = a.T.Remove(key)
+	return
+}
+
+// adapter::Visit
+func (a *adapter) Visit(key interface{}, f visitor.F) (value interface{}, result visitor.Result) {
+	a.T, value, result = a.T.Visit(key, f)
+	return
+}
+
+/**********************************************************************
+ ** Conformance Tests
+ **********************************************************************/
+
+// Test_BSTTest_T
+func Test_BSTTest_T(t *testing.T) {
+	bsttest.RunT(t, func(fcmp cmp.F) bst.T { return newAdapter(fcmp) })
+}
+
+// Test_BSTTest_Finder
+func Test_BSTTest_Finder(t *testing.T) {
+	bsttest.RunFinder(t, func(fcmp cmp.F) bsttest.Finder { return newAdapter(fcmp) })
+}
+
+// Test_BSTTest_Visitor
+func Test_BSTTest_Visitor(t *testing.T) {
+	bsttest.RunVisitor(t, func(fcmp cmp.F) bsttest.Visitor { return newAdapter(fcmp) })
+}
+
+// Test_BSTTest_Walker
+func Test_BSTTest_Walker(t *testing.T) {
+	bsttest.RunWalker(t, func(fcmp cmp.F) bsttest.Walker { return newAdapter(fcmp) })
+}
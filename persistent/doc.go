@@ -0,0 +1,91 @@
+/*
+
+Package persistent provides a persistent (immutable) implementation
+of an extensible Binary Search Tree, as defined in the go_bst
+package and sub-packages.
+
+Each T is one version of the tree.  Methods that would modify the
+tree instead return a new version, copying only the nodes on the
+path to the changed key, and sharing every other node with the
+version they were called on.  Versions are never modified, so any
+number of goroutines can read any version, while a writer keeps
+creating new ones, without locking.
+
+
+Standard BST Methods
+--------------------
+
+ * Empty
+ * Get
+ * ReplaceOrInsert (returns a new version)
+ * Remove          (returns a new version)
+
+Since ReplaceOrInsert and Remove return a new version, T does not
+satisfy bst.T.
+
+
+Extensible BST Methods
+----------------------
+
+ * Find  (see finder.T)
+ * Walk  (see walker.T)
+ * Visit (returns a new version, see visitor.I)
+
+Every version satisfies finder.I and walker.I, so the finder and
+walker helpers (LowerBound, UpperBound, ForeachMin, etc) work on
+any version.
+
+
+Additional BST Methods
+----------------------
+
+The following additional BST methods have been implemented:
+
+ * Size (see bst.I_Size)
+ * Min  (see finder.I_Min)
+ * Max  (see finder.I_Max)
+
+
+Unchanged Versions
+------------------
+
+Remove of a key that is not found, and Visit when the visitor
+returns visitor.GET, return the version they were called on.
+
+
+Leaning
+-------
+
+As with the simple package, this implementation uses a 'toggle'
+mechanism to decide if it should remove from the left or the right
+when both options are available.  The toggle is part of each version.
+
+
+Effeciency
+----------
+
+Each change allocates one node per level of the path to the changed
+key (and, for removals, the path to its replacement).  As with the
+simple package, the tree is not balanced, and the following
+functions use recursion:
+
+ * ReplaceOrInsert
+ * Remove
+ * Visit
+ * Walk
+
+
+License
+-------
+
+This package is released under the MIT License.
+See included file 'LICENSE' for more details.
+
+
+Contributors
+------------
+
+David Farell <DavidPFarrell@yahoo.com>
+
+*/
+package persistent
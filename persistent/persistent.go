@@ -0,0 +1,208 @@
+package persistent
+
+import (
+	"github.com/iNamik/go_bst"
+	"github.com/iNamik/go_bst/finder"
+	"github.com/iNamik/go_bst/visitor"
+	"github.com/iNamik/go_bst/walker"
+	"github.com/iNamik/go_cmp"
+)
+
+/**********************************************************************
+ ** Types & Interfaces
+ **********************************************************************/
+
+// T is one version of a persistent tree.
+// Methods that would modify the tree instead return a new version,
+// leaving the version they were called on unchanged.
+type T interface {
+	bst.I_Empty
+	bst.I_Size
+	bst.I_Get
+	finder.I
+	walker.I
+	finder.I_Min
+	finder.I_Max
+	// ReplaceOrInsert returns a new version with key set to value,
+	// and true if key was replaced, false if it was inserted
+	ReplaceOrInsert(key interface{}, value interface{}) (_ T, replaced bool)
+	// Remove returns a new version without key, and true if key was removed.
+	// If key was not found, the same version is returned.
+	Remove(key interface{}) (_ T, removed bool)
+	// Visit returns a new version reflecting the action taken by f (see visitor.I).
+	// If f returns visitor.GET, the same version is returned.
+	Visit(key interface{}, f visitor.F) (_ T, value interface{}, result visitor.Result)
+}
+
+// node is never modified once it is part of a version
+type node struct {
+	key   interface{}
+	value interface{}
+	left  *node
+	right *node
+}
+
+// tree
+type tree struct {
+	root *node
+	fcmp cmp.F
+	left bool // To randomize removal of nodes
+	size int
+}
+
+/**********************************************************************
+ ** Public Functions
+ **********************************************************************/
+
+// New returns an empty version
+func New(fcmp cmp.F) T {
+	return &tree{root: nil, fcmp: fcmp, left: true, size: 0}
+}
+
+// tree:Empty
+func (t *tree) Empty() bool {
+	return t.size == 0
+}
+
+// tree:Size
+func (t *tree) Size() int {
+	return t.size
+}
+
+// tree:ReplaceOrInsert
+func (t *tree) ReplaceOrInsert(key interface{}, value interface{}) (T, bool) {
+	root, replaced := replaceOrInsert(t.root, key, value, t.fcmp)
+	size := t.size
+	if !replaced {
+		size++
+	}
+	return &tree{root: root, fcmp: t.fcmp, left: t.left, size: size}, replaced
+}
+
+// tree::Get
+func (t *tree) Get(key interface{}) (interface{}, bool) {
+	h := get(t.root, key, t.fcmp)
+	if h != nil {
+		return h.value, true
+	}
+	return nil, false
+}
+
+// tree::Remove
+func (t *tree) Remove(key interface{}) (T, bool) {
+	root, removed, left := remove(t.root, key, t.fcmp, t.left)
+	if !removed {
+		return t, false
+	}
+	return &tree{root: root, fcmp: t.fcmp, left: left, size: t.size - 1}, true
+}
+
+/**********************************************************************
+ ** Private Functions
+ **********************************************************************/
+
+// replaceOrInsert returns a copy of h, copying the path to key,
+// and true if key was replaced, false if it was inserted
+func replaceOrInsert(h *node, key interface{}, value interface{}, fcmp cmp.F) (*node, bool) {
+	if h == nil {
+		return &node{key: key, value: value}, false
+	}
+	n := *h
+	replaced := true
+	switch fcmp(key, h.key) {
+	case cmp.LT:
+		n.left, replaced = replaceOrInsert(h.left, key, value, fcmp)
+	case cmp.GT:
+		n.right, replaced = replaceOrInsert(h.right, key, value, fcmp)
+	default:
+		n.value = value
+	}
+	return &n, replaced
+}
+
+// get
+func get(h *node, key interface{}, fcmp cmp.F) *node {
+	for h != nil {
+		switch fcmp(key, h.key) {
+		case cmp.LT:
+			h = h.left
+		case cmp.GT:
+			h = h.right
+		default:
+			return h
+		}
+	}
+	return nil
+}
+
+// remove returns a copy of h, copying the path to key, if key was
+// removed, otherwise h itself
+func remove(h *node, key interface{}, fcmp cmp.F, left bool) (*node, bool, bool) {
+	if h == nil {
+		return nil, false, left
+	}
+	var child *node
+	removed := false
+	switch fcmp(key, h.key) {
+	case cmp.LT:
+		child, removed, left = remove(h.left, key, fcmp, left)
+		if removed {
+			n := *h
+			n.left = child
+			return &n, true, left
+		}
+	case cmp.GT:
+		child, removed, left = remove(h.right, key, fcmp, left)
+		if removed {
+			n := *h
+			n.right = child
+			return &n, true, left
+		}
+	default:
+		h, left = removeNode(h, left)
+		return h, true, left
+	}
+	return h, false, left
+}
+
+// removeNode returns the subtree that replaces h, copying the path
+// to the neighbor that takes its place
+func removeNode(h *node, left bool) (*node, bool) {
+	// If we want left or if there is no right
+	if h.left != nil && (left || h.right == nil) {
+		// If we have both left and right, then use right next time
+		left = !(h.right != nil)
+		l, m := removeMax(h.left)
+		return &node{key: m.key, value: m.value, left: l, right: h.right}, left
+	}
+	// We want right or there is no left
+	if h.right != nil {
+		// If we have both left and right, then use left next time
+		left = (h.left != nil)
+		r, m := removeMin(h.right)
+		return &node{key: m.key, value: m.value, left: h.left, right: r}, left
+	}
+	return nil, left
+}
+
+// removeMin returns a copy of h without min(h), and min(h)
+func removeMin(h *node) (*node, *node) {
+	if h.left == nil {
+		return h.right, h
+	}
+	l, m := removeMin(h.left)
+	n := *h
+	n.left = l
+	return &n, m
+}
+
+// removeMax returns a copy of h without max(h), and max(h)
+func removeMax(h *node) (*node, *node) {
+	if h.right == nil {
+		return h.left, h
+	}
+	r, m := removeMax(h.right)
+	n := *h
+	n.right = r
+	return &n, m
+}
@@ -0,0 +1,243 @@
+package persistent
+
+import (
+	"math/rand"
+	"sync"
+	"testing"
+)
+
+import (
+	"github.com/iNamik/go_bst/finder"
+	"github.com/iNamik/go_bst/visitor"
+	"github.com/iNamik/go_bst/walker"
+	"github.com/iNamik/go_cmp"
+)
+
+/**********************************************************************
+ ** Test Data
+ **********************************************************************/
+
+// SEED keeps the tests repeatable
+const SEED = 1
+
+// random
+var random = rand.New(rand.NewSource(SEED))
+
+/**********************************************************************
+ ** Assert Functions
+ **********************************************************************/
+
+// assertKeys confirms that r holds exactly keys, in order, with value = key
+func assertKeys(r T, keys []int, t *testing.T) {
+	if size := r.Size(); size != len(keys) {
+		t.Fatalf("Size() returned %d instead of %d", size, len(keys))
+	}
+	i := 0
+	walker.ForeachMin(r, func(k interface{}, v interface{}) {
+		if i >= len(keys) || k != keys[i] || v != keys[i] {
+			t.Fatalf("walked (%v, %v) at index %d instead of key %v", k, v, i, keys)
+		}
+		i++
+	})
+	if i != len(keys) {
+		t.Fatalf("walked %d keys instead of %d", i, len(keys))
+	}
+}
+
+/**********************************************************************
+ ** Helper Functions
+ **********************************************************************/
+
+// randomTree returns a version with keys 0..n-1 inserted in random order, with value = key
+func randomTree(n int) T {
+	r := New(cmp.F_int)
+	for _, k := range random.Perm(n) {
+		r, _ = r.ReplaceOrInsert(k, k)
+	}
+	return r
+}
+
+// sequence returns the keys 0..n-1
+func sequence(n int) []int {
+	keys := make([]int, n)
+	for i := range keys {
+		keys[i] = i
+	}
+	return keys
+}
+
+// count returns the number of distinct nodes reachable from the roots of versions
+func count(versions ...T) int {
+	seen := map[*node]bool{}
+	var visit func(h *node)
+	visit = func(h *node) {
+		if h != nil && !seen[h] {
+			seen[h] = true
+			visit(h.left)
+			visit(h.right)
+		}
+	}
+	for _, v := range versions {
+		visit(v.(*tree).root)
+	}
+	return len(seen)
+}
+
+/**********************************************************************
+ ** Test Functions
+ **********************************************************************/
+
+// Test_Versions confirms that every change leaves older versions intact
+func Test_Versions(t *testing.T) {
+	const SIZE = 100
+	r := New(cmp.F_int)
+	model := map[int]bool{}
+	versions := []T{r}
+	expected := [][]int{{}}
+	// snapshot records the current version and the keys it should hold
+	snapshot := func() {
+		versions = append(versions, r)
+		keys := []int{}
+		for k := 0; k < SIZE; k++ {
+			if model[k] {
+				keys = append(keys, k)
+			}
+		}
+		expected = append(expected, keys)
+	}
+	var changed bool
+	for _, k := range random.Perm(SIZE) {
+		r, changed = r.ReplaceOrInsert(k, k)
+		if changed {
+			t.Fatalf("ReplaceOrInsert(%d) returned true for a new key", k)
+		}
+		model[k] = true
+		snapshot()
+	}
+	for _, k := range random.Perm(SIZE) {
+		r, changed = r.Remove(k)
+		if !changed {
+			t.Fatalf("Remove(%d) returned false for an existing key", k)
+		}
+		delete(model, k)
+		snapshot()
+	}
+	for i, v := range versions {
+		assertKeys(v, expected[i], t)
+	}
+}
+
+// Test_Unchanged confirms that operations which do not change the tree return the same version
+func Test_Unchanged(t *testing.T) {
+	r := randomTree(10)
+	if r_, removed := r.Remove(100); r_ != r || removed {
+		t.Fatalf("Remove() of a missing key returned a new version")
+	}
+	get := func(_ interface{}, _ bool) (interface{}, visitor.Action) { return nil, visitor.GET }
+	for _, k := range []int{5, 100} {
+		if r_, _, _ := r.Visit(k, get); r_ != r {
+			t.Fatalf("Visit(%d) returning GET returned a new version", k)
+		}
+	}
+}
+
+// Test_Visit confirms that Visit returns new versions, leaving the old version intact
+func Test_Visit(t *testing.T) {
+	r := randomTree(10)
+	r2, value, result := r.Visit(5, func(value interface{}, found bool) (interface{}, visitor.Action) {
+		return value.(int) * 10, visitor.REPLACE
+	})
+	if value != 50 || result != visitor.REPLACED {
+		t.Fatalf("Visit(5) returned (%v, %s)", value, result)
+	}
+	r3, value, result := r2.Visit(5, func(_ interface{}, _ bool) (interface{}, visitor.Action) { return nil, visitor.REMOVE })
+	if value != 50 || result != visitor.REMOVED {
+		t.Fatalf("Visit(5) returned (%v, %s)", value, result)
+	}
+	for _, test := range []struct {
+		r     T
+		value interface{}
+		found bool
+	}{{r, 5, true}, {r2, 50, true}, {r3, nil, false}} {
+		if value, found := test.r.Get(5); value != test.value || found != test.found {
+			t.Fatalf("Get(5) returned (%v, %v) instead of (%v, %v)", value, found, test.value, test.found)
+		}
+	}
+	assertKeys(r, sequence(10), t)
+}
+
+// Test_Sharing confirms that a new version copies only the path to the changed key
+func Test_Sharing(t *testing.T) {
+	const SIZE = 1000
+	r := randomTree(SIZE)
+	for _, k := range random.Perm(SIZE)[:100] {
+		r2, _ := r.ReplaceOrInsert(k, k)
+		depth := 0 // Number of nodes on the path to k
+		r.Find(func(n finder.Node) finder.Action {
+			depth++
+			switch n.Cmp(k, n.Key()) {
+			case cmp.LT:
+				return finder.LEFT
+			case cmp.GT:
+				return finder.RIGHT
+			}
+			return finder.FOUND
+		})
+		if count(r, r2) != SIZE+depth {
+			t.Fatalf("ReplaceOrInsert(%d) copied %d nodes instead of %d", k, count(r, r2)-SIZE, depth)
+		}
+		// Remove also copies the path from k to the neighbor that replaces it
+		r3, _ := r.Remove(k)
+		if count(r, r3) > SIZE+height(r.(*tree).root) {
+			t.Fatalf("Remove(%d) copied %d nodes", k, count(r, r3)-SIZE)
+		}
+	}
+}
+
+// Test_Concurrent walks older versions while a writer keeps creating new ones.
+// Run with -race to confirm that versions are never modified.
+func Test_Concurrent(t *testing.T) {
+	const SIZE = 1000
+	const READERS = 4
+	r := randomTree(SIZE)
+	versions := make(chan T, READERS)
+	wg := &sync.WaitGroup{}
+	for i := 0; i < READERS; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for v := range versions {
+				keys := []int{}
+				walker.ForeachMin(v, func(k interface{}, _ interface{}) { keys = append(keys, k.(int)) })
+				if len(keys) != v.Size() {
+					t.Errorf("walked %d keys instead of %d", len(keys), v.Size())
+				}
+				finder.LowerBound(v, SIZE/2)
+				walker.UpperBound(v, SIZE/2)
+			}
+		}()
+	}
+	for i := 0; i < 200; i++ {
+		versions <- r
+		k := random.Intn(SIZE)
+		if i%2 == 0 {
+			r, _ = r.Remove(k)
+		} else {
+			r, _ = r.ReplaceOrInsert(k, k)
+		}
+	}
+	close(versions)
+	wg.Wait()
+}
+
+// height
+func height(h *node) int {
+	if h == nil {
+		return 0
+	}
+	l, r := height(h.left), height(h.right)
+	if l > r {
+		return l + 1
+	}
+	return r + 1
+}
@@ -0,0 +1,75 @@
+package persistent
+
+import "fmt"
+
+import (
+	"github.com/iNamik/go_bst/visitor"
+	"github.com/iNamik/go_cmp"
+)
+
+// tree::Visit
+func (t *tree) Visit(key interface{}, f visitor.F) (T, interface{}, visitor.Result) {
+	root, value, result, left := visit(t.root, key, t.fcmp, f, t.left)
+	size := t.size
+	switch result {
+	case visitor.FOUND, visitor.NOT_FOUND:
+		return t, value, result
+	case visitor.INSERTED:
+		size++
+	case visitor.REMOVED:
+		size--
+	}
+	return &tree{root: root, fcmp: t.fcmp, left: left, size: size}, value, result
+}
+
+// visit returns a copy of h, copying the path to key, if the tree
+// was changed, otherwise h itself
+func visit(h *node, key interface{}, fcmp cmp.F, f visitor.F, left bool) (_ *node, value interface{}, result visitor.Result, _ bool) {
+	if h == nil {
+		var action visitor.Action
+		value, action = f(nil, false)
+		switch action {
+		case visitor.INSERT:
+			return &node{key: key, value: value}, value, visitor.INSERTED, left
+		case visitor.GET:
+			return nil, nil, visitor.NOT_FOUND, left
+		default:
+			panic(fmt.Sprintf("illegal action '%s' when visiting non-found key", action))
+		}
+	}
+	var child *node
+	switch fcmp(key, h.key) {
+	case cmp.LT:
+		child, value, result, left = visit(h.left, key, fcmp, f, left)
+		if result != visitor.FOUND && result != visitor.NOT_FOUND {
+			n := *h
+			n.left = child
+			return &n, value, result, left
+		}
+	case cmp.GT:
+		child, value, result, left = visit(h.right, key, fcmp, f, left)
+		if result != visitor.FOUND && result != visitor.NOT_FOUND {
+			n := *h
+			n.right = child
+			return &n, value, result, left
+		}
+	default:
+		var action visitor.Action
+		value, action = f(h.value, true)
+		switch action {
+		case visitor.GET:
+			return h, h.value, visitor.FOUND, left
+		case visitor.REPLACE:
+			n := *h
+			n.value = value
+			return &n, value, visitor.REPLACED, left
+		case visitor.REMOVE:
+			value = h.value
+			h, left = removeNode(h, left)
+			return h, value, visitor.REMOVED, left
+		default:
+			panic(fmt.Sprintf("illegal action '%s' when visiting found key", action))
+		}
+	}
+	return h, value, result, left
+}
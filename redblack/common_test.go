@@ -0,0 +1,185 @@
+package redblack
+
+import (
+	"math/rand"
+	"testing"
+)
+
+import (
+	"github.com/iNamik/go_cmp"
+)
+
+/**********************************************************************
+ ** Random Source
+ **********************************************************************/
+
+// SEED is used for all randomness in the tests, so that any
+// failure, including the shape of the tree, is reproducible
+const SEED = 1
+
+// random
+var random = rand.New(rand.NewSource(SEED))
+
+/**********************************************************************
+ ** Test Data
+ **********************************************************************/
+
+const key1 = 1
+const key2 = 2
+const key3 = 3
+
+/**********************************************************************
+ ** Assert Functions
+ **********************************************************************/
+
+// assertEmpty
+func assertEmpty(r T, empty bool, t *testing.T) {
+	if empty_ := r.Empty(); empty_ != empty {
+		t.Fatalf("Empty() returned %v instead of %v", empty_, empty)
+	}
+}
+
+// assertSize
+func assertSize(r T, size int, t *testing.T) {
+	if size_ := r.Size(); size_ != size {
+		t.Fatalf("Size() returned %v instead of %v", size_, size)
+	}
+}
+
+// assertReplaceOrInsert
+func assertReplaceOrInsert(r T, key int, value interface{}, replaced bool, t *testing.T) {
+	if replaced_ := r.ReplaceOrInsert(key, value); replaced_ != replaced {
+		t.Fatalf("ReplaceOrInsert(%v) returned %v instead of %v", key, replaced_, replaced)
+	}
+}
+
+// assertGet
+func assertGet(r T, key int, value_ interface{}, found bool, t *testing.T) {
+	v_, found_ := r.Get(key)
+	if found_ != found {
+		t.Fatalf("Get() returned %v", found_)
+	}
+	if found == true {
+		if v_ != value_ {
+			t.Fatalf("Get() returned value '%v' instead of '%v'", v_, value_)
+		}
+	}
+}
+
+// assertRemove
+func assertRemove(r T, key interface{}, removed bool, t *testing.T) {
+	if removed_ := r.Remove(key); removed_ != removed {
+		t.Fatalf("Remove(%v) returned %v instead of %v", key, removed_, removed)
+	}
+}
+
+// assertKVF calls a func of type func()(key,value,found) and confirms the results
+func assertKVF(key int, value_ interface{}, found bool, f func() (interface{}, interface{}, bool), t *testing.T) {
+	k_, v_, found_ := f()
+	if found_ != found {
+		t.Fatalf("func returned %v", found_)
+	}
+	if found == true {
+		k, ok := k_.(int)
+		if ok == false {
+			t.Fatal("func did not return key of type int")
+		}
+		if k != key {
+			t.Fatalf("func returned key '%d' instead of '%d'", k, key)
+		}
+		if v_ != value_ {
+			t.Fatalf("func returned value '%v' instead of '%v'", v_, value_)
+		}
+	}
+}
+
+// assertPanic
+func assertPanic(t *testing.T, msg string, f func()) {
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Fatal("assertPanic: did not generate panic()")
+		} else if r != msg {
+			t.Fatalf("assertPanic: recover() recieved message '%s' instead of '%s'", r, msg)
+		}
+	}()
+	f()
+}
+
+// assertRB confirms that the tree is a BST and that it satisfies the
+// left-leaning red-black invariants: the root is black, no red link leans
+// right, no two red links are in a row, and every path from the root to
+// nil has the same number of black links
+func assertRB(r T, t *testing.T) {
+	root := r.(*tree).root
+	if isRed(root) {
+		t.Fatalf("root is red")
+	}
+	if _, ok := isRB(root, nil, nil, t); !ok {
+		t.Fatalf("tree is not a red-black tree")
+	}
+}
+
+/**********************************************************************
+ ** Helper Functions
+ **********************************************************************/
+
+// randomTree
+func randomTree(n int) T {
+	r := New(cmp.F_int)
+	for _, i := range random.Perm(n) {
+		r.ReplaceOrInsert(i, i)
+	}
+	return r
+}
+
+// randomTreeDouble
+func randomTreeDouble(n int) T {
+	r := New(cmp.F_int)
+	for _, i_ := range random.Perm(n) {
+		i := i_ + i_
+		r.ReplaceOrInsert(i, i)
+	}
+	return r
+}
+
+/**********************************************************************
+ ** Integritry Functions
+ **********************************************************************/
+
+// isRB returns the black height of x, and whether the subtree rooted
+// at x is a valid left-leaning red-black tree with all keys strictly
+// between min and max (nil meaning unbounded)
+func isRB(x *node, min interface{}, max interface{}, t *testing.T) (int, bool) {
+	if x == nil {
+		return 0, true
+	}
+	if (min != nil && cmp.F_int(x.key, min) != cmp.GT) || (max != nil && cmp.F_int(x.key, max) != cmp.LT) {
+		t.Errorf("key %v is out of order", x.key)
+		return 0, false
+	}
+	if isRed(x.right) {
+		t.Errorf("key %v has a red right link", x.key)
+		return 0, false
+	}
+	if isRed(x) && isRed(x.left) {
+		t.Errorf("key %v has two red links in a row", x.key)
+		return 0, false
+	}
+	bl, ok := isRB(x.left, min, x.key, t)
+	if !ok {
+		return 0, false
+	}
+	br, ok := isRB(x.right, x.key, max, t)
+	if !ok {
+		return 0, false
+	}
+	if bl != br {
+		t.Errorf("key %v has paths with %d and %d black links", x.key, bl, br)
+		return 0, false
+	}
+	if !isRed(x) {
+		bl++
+	}
+	return bl, true
+}
@@ -0,0 +1,91 @@
+/*
+
+Package redblack provides a self-balancing implementation of an
+extensible Binary Search Tree as defined in the go_bst
+package and sub-packages.
+
+The tree is kept balanced using the left-leaning red-black
+algorithm, so its height never exceeds 2*log2(n+1), regardless
+of the order in which keys are inserted.  It exposes the same API
+as the simple and avl packages and can be used anywhere they are.
+
+
+Standard BST Methods
+--------------------
+
+All of the standard BST methods required to satisfy the
+bst.T interface have been implemented:
+
+ * Empty
+ * ReplaceOrInsert
+ * Get
+ * Remove
+
+
+Extensible BST Methods
+----------------------
+
+All of the extensible interfaces have been implemented:
+
+ * Find  (see finder.T)
+ * Visit (see visitor.T)
+ * Walk  (see walker.T)
+
+
+Additional BST Methods
+----------------------
+
+The following additional BST methods have been implemented:
+
+ * Size (see bst.I_Size)
+ * Min  (see finder.I_Min)
+ * Max  (see finder.I_Max)
+
+
+Balancing
+---------
+
+Each node stores the color of the link from its parent.
+Compared to an AVL tree, a red-black tree is less rigidly
+balanced, trading slightly longer searches for fewer rotations
+when keys are inserted or removed, which suits write-heavy
+workloads.
+
+Removal pushes a red link down the search path before the key
+is found, so it is only performed once the key is known to be
+in the tree.  As a consequence, Visit first searches for the key
+and only then performs the INSERT or REMOVE action, using a
+second pass from the root.
+
+
+Effeciency
+----------
+
+Instead of storing parent and sibling information on each node,
+this implementation uses recursion to accomplish various tree
+navigation functions.  Specifically, the following functions
+use recursion:
+
+ * ReplaceOrInsert
+ * Remove
+ * Visit
+ * Walk
+
+Since the tree is balanced, the depth of the recursion is
+bounded by O(log n).
+
+
+License
+-------
+
+This package is released under the MIT License.
+See included file 'LICENSE' for more details.
+
+
+Contributors
+------------
+
+David Farell <DavidPFarrell@yahoo.com>
+
+*/
+package redblack
@@ -0,0 +1,281 @@
+package redblack
+
+import (
+	"github.com/iNamik/go_bst"
+	"github.com/iNamik/go_bst/finder"
+	"github.com/iNamik/go_bst/visitor"
+	"github.com/iNamik/go_bst/walker"
+	"github.com/iNamik/go_cmp"
+)
+
+import (
+	"sync"
+)
+
+/**********************************************************************
+ ** Types & Interfaces
+ **********************************************************************/
+
+// T
+type T interface {
+	bst.T
+	finder.I
+	visitor.I
+	walker.I
+	bst.I_Size
+	finder.I_Min
+	finder.I_Max
+}
+
+// node
+type node struct {
+	key   interface{}
+	value interface{}
+	left  *node
+	right *node
+	red   bool // Color of the link from the parent to this node
+}
+
+// tree
+type tree struct {
+	mutex *sync.Mutex
+	root  *node
+	fcmp  cmp.F
+	size  int
+}
+
+/**********************************************************************
+ ** Public Functions
+ **********************************************************************/
+
+// New
+func New(fcmp cmp.F) T {
+	return &tree{mutex: &sync.Mutex{}, root: nil, fcmp: fcmp, size: 0}
+}
+
+// tree:Empty
+func (t *tree) Empty() bool {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return t.size == 0
+}
+
+// tree:Size
+func (t *tree) Size() int {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return t.size
+}
+
+// tree:ReplaceOrInsert
+func (t *tree) ReplaceOrInsert(key interface{}, value interface{}) bool {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return t.replaceOrInsert(key, value)
+}
+
+// tree::Get
+func (t *tree) Get(key interface{}) (interface{}, bool) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	h := get(t.root, key, t.fcmp)
+	if h != nil {
+		return h.value, true
+	}
+	return nil, false
+}
+
+// tree::Remove
+func (t *tree) Remove(key interface{}) bool {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	// remove() requires the key to be in the tree
+	if get(t.root, key, t.fcmp) == nil {
+		return false
+	}
+	t.remove(key)
+	return true
+}
+
+/**********************************************************************
+ ** Private Functions
+ **********************************************************************/
+
+// tree::replaceOrInsert assumes the lock is held
+func (t *tree) replaceOrInsert(key interface{}, value interface{}) bool {
+	var replaced bool
+	t.root, replaced = replaceOrInsert(t.root, key, value, t.fcmp)
+	t.root.red = false
+	if !replaced {
+		t.size++
+	}
+	return replaced
+}
+
+// tree::remove assumes the lock is held and that key is in the tree
+func (t *tree) remove(key interface{}) {
+	// If both children of root are black, set root to red
+	if !isRed(t.root.left) && !isRed(t.root.right) {
+		t.root.red = true
+	}
+	t.root = remove(t.root, key, t.fcmp)
+	if t.root != nil {
+		t.root.red = false
+	}
+	t.size--
+}
+
+// replaceOrInsert returns true if key was replaced, false if it was inserted into the tree
+func replaceOrInsert(h *node, key interface{}, value interface{}, fcmp cmp.F) (*node, bool) {
+	if h == nil {
+		return &node{key: key, value: value, red: true}, false
+	}
+	replaced := true
+	switch fcmp(key, h.key) {
+	case cmp.LT:
+		h.left, replaced = replaceOrInsert(h.left, key, value, fcmp)
+	case cmp.GT:
+		h.right, replaced = replaceOrInsert(h.right, key, value, fcmp)
+	default:
+		h.value = value
+	}
+	// Only an insert can change the shape of the tree
+	if !replaced {
+		h = balance(h)
+	}
+	return h, replaced
+}
+
+// get
+func get(h *node, key interface{}, fcmp cmp.F) *node {
+	for h != nil {
+		switch fcmp(key, h.key) {
+		case cmp.LT:
+			h = h.left
+		case cmp.GT:
+			h = h.right
+		default:
+			return h
+		}
+	}
+	return nil
+}
+
+// remove assumes key is in the subtree rooted at h.
+// It pushes a red link down the search path so that the
+// node eventually removed is never a 2-node.
+func remove(h *node, key interface{}, fcmp cmp.F) *node {
+	if fcmp(key, h.key) == cmp.LT {
+		if !isRed(h.left) && !isRed(h.left.left) {
+			h = moveRedLeft(h)
+		}
+		h.left = remove(h.left, key, fcmp)
+	} else {
+		// From here on key >= h.key, so !GT means EQ
+		if isRed(h.left) {
+			h = rotateRight(h)
+		}
+		if fcmp(key, h.key) != cmp.GT && h.right == nil {
+			return nil
+		}
+		if !isRed(h.right) && !isRed(h.right.left) {
+			h = moveRedRight(h)
+		}
+		if fcmp(key, h.key) != cmp.GT {
+			// Replace h with min(h.right)
+			var n *node
+			h.right, n = removeMin(h.right)
+			n.left, n.right, n.red = h.left, h.right, h.red
+			h = n
+		} else {
+			h.right = remove(h.right, key, fcmp)
+		}
+	}
+	return balance(h)
+}
+
+// removeMin detaches min(h), returning the new (balanced) subtree root and the detached node
+func removeMin(h *node) (*node, *node) {
+	if h.left == nil {
+		return nil, h
+	}
+	if !isRed(h.left) && !isRed(h.left.left) {
+		h = moveRedLeft(h)
+	}
+	var n *node
+	h.left, n = removeMin(h.left)
+	return balance(h), n
+}
+
+/**********************************************************************
+ ** Balancing Functions
+ **********************************************************************/
+
+// isRed treats nil links as black
+func isRed(h *node) bool {
+	return h != nil && h.red
+}
+
+// rotateLeft turns a right-leaning red link into a left-leaning one
+func rotateLeft(h *node) *node {
+	x := h.right
+	h.right = x.left
+	x.left = h
+	x.red = h.red
+	h.red = true
+	return x
+}
+
+// rotateRight turns a left-leaning red link into a right-leaning one
+func rotateRight(h *node) *node {
+	x := h.left
+	h.left = x.right
+	x.right = h
+	x.red = h.red
+	h.red = true
+	return x
+}
+
+// flipColors flips the colors of h and its two children
+func flipColors(h *node) {
+	h.red = !h.red
+	h.left.red = !h.left.red
+	h.right.red = !h.right.red
+}
+
+// moveRedLeft assumes h is red and both h.left and h.left.left are black,
+// making h.left or one of its children red
+func moveRedLeft(h *node) *node {
+	flipColors(h)
+	if isRed(h.right.left) {
+		h.right = rotateRight(h.right)
+		h = rotateLeft(h)
+		flipColors(h)
+	}
+	return h
+}
+
+// moveRedRight assumes h is red and both h.right and h.right.left are black,
+// making h.right or one of its children red
+func moveRedRight(h *node) *node {
+	flipColors(h)
+	if isRed(h.left.left) {
+		h = rotateRight(h)
+		flipColors(h)
+	}
+	return h
+}
+
+// balance restores the left-leaning red-black invariants at h
+func balance(h *node) *node {
+	if isRed(h.right) && !isRed(h.left) {
+		h = rotateLeft(h)
+	}
+	if isRed(h.left) && isRed(h.left.left) {
+		h = rotateRight(h)
+	}
+	if isRed(h.left) && isRed(h.right) {
+		flipColors(h)
+	}
+	return h
+}
@@ -0,0 +1,44 @@
+package redblack
+
+import "fmt"
+
+import (
+	"github.com/iNamik/go_bst/visitor"
+)
+
+// tree::Visit searches for the key before deciding on an action.
+// Since removal restructures the tree on the way down, the INSERT
+// and REMOVE actions make a second pass from the root.
+func (t *tree) Visit(key interface{}, f visitor.F) (value interface{}, result visitor.Result) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	h := get(t.root, key, t.fcmp)
+	if h == nil {
+		var action visitor.Action
+		value, action = f(nil, false)
+		switch action {
+		case visitor.INSERT:
+			t.replaceOrInsert(key, value)
+			return value, visitor.INSERTED
+		case visitor.GET:
+			return nil, visitor.NOT_FOUND
+		default:
+			panic(fmt.Sprintf("illegal action '%s' when visiting non-found key", action))
+		}
+	}
+	var action visitor.Action
+	value, action = f(h.value, true)
+	switch action {
+	case visitor.GET:
+		return h.value, visitor.FOUND
+	case visitor.REPLACE:
+		h.value = value
+		return value, visitor.REPLACED
+	case visitor.REMOVE:
+		value = h.value
+		t.remove(key)
+		return value, visitor.REMOVED
+	default:
+		panic(fmt.Sprintf("illegal action '%s' when visiting found key", action))
+	}
+}
@@ -0,0 +1,134 @@
+package set
+
+import (
+	"sort"
+)
+
+import (
+	"github.com/iNamik/go_cmp"
+)
+
+// set::Union
+func (s *set) Union(other T) T {
+	return s.merge(other, true, true, true)
+}
+
+// set::Intersection
+func (s *set) Intersection(other T) T {
+	return s.merge(other, false, true, false)
+}
+
+// set::Difference
+func (s *set) Difference(other T) T {
+	return s.merge(other, true, false, false)
+}
+
+// set::SymmetricDifference
+func (s *set) SymmetricDifference(other T) T {
+	return s.merge(other, true, false, true)
+}
+
+// set::IsSubset collects the keys of both sets before comparing them,
+// rather than calling other.Contains while walking s, which would call
+// back into the tree being walked when other is s
+func (s *set) IsSubset(other T) bool {
+	a, b := keys(s), s.sorted(keys(other))
+	j := 0
+	for _, key := range a {
+		// Skip the keys of other that are less than key
+		for j < len(b) && s.fcmp(b[j], key) == cmp.LT {
+			j++
+		}
+		if j == len(b) || s.fcmp(b[j], key) == cmp.GT {
+			return false
+		}
+		j++
+	}
+	return true
+}
+
+// merge walks both sets in order, returning a new set with the keys
+// only in s (if left), in both sets (if both) and only in other (if right)
+func (s *set) merge(other T, left bool, both bool, right bool) T {
+	a, b := keys(s), s.sorted(keys(other))
+	var merged []interface{}
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch s.fcmp(a[i], b[j]) {
+		case cmp.LT:
+			if left {
+				merged = append(merged, a[i])
+			}
+			i++
+		case cmp.GT:
+			if right {
+				merged = append(merged, b[j])
+			}
+			j++
+		default:
+			if both {
+				merged = append(merged, a[i])
+			}
+			i++
+			j++
+		}
+	}
+	if left {
+		merged = append(merged, a[i:]...)
+	}
+	if right {
+		merged = append(merged, b[j:]...)
+	}
+	r := &set{i: s.fnew(s.fcmp), fcmp: s.fcmp, fnew: s.fnew}
+	fill(r.i, merged)
+	return r
+}
+
+// keys returns the keys of s in ascending order
+func keys(s T) []interface{} {
+	var keys []interface{}
+	s.ForeachMin(func(key interface{}) {
+		keys = append(keys, key)
+	})
+	return keys
+}
+
+// sorted returns the keys of another set, which are in the order of its
+// comparator, in strictly ascending order by s's comparator.
+// They are returned as they are when the comparators agree, as they
+// usually do, otherwise they are sorted, dropping keys that s's
+// comparator considers equal to an earlier key.
+func (s *set) sorted(keys []interface{}) []interface{} {
+	for i := 1; i < len(keys); i++ {
+		if s.fcmp(keys[i-1], keys[i]) != cmp.LT {
+			return s.sort(keys)
+		}
+	}
+	return keys
+}
+
+// sort sorts keys in place by s's comparator, removing duplicates
+func (s *set) sort(keys []interface{}) []interface{} {
+	sort.SliceStable(keys, func(i int, j int) bool {
+		return s.fcmp(keys[i], keys[j]) == cmp.LT
+	})
+	unique := keys[:1]
+	for _, key := range keys[1:] {
+		if s.fcmp(unique[len(unique)-1], key) == cmp.LT {
+			unique = append(unique, key)
+		}
+	}
+	return unique
+}
+
+// fill inserts the sorted keys into i, median first, so that trees
+// which do not balance themselves are still balanced
+func fill(i I, keys []interface{}) {
+	if len(keys) == 0 {
+		return
+	}
+	m := len(keys) / 2
+	i.ReplaceOrInsert(keys[m], nil)
+	fill(i, keys[:m])
+	fill(i, keys[m+1:])
+}
@@ -0,0 +1,164 @@
+package set
+
+import (
+	"testing"
+)
+
+import (
+	"github.com/iNamik/go_bst"
+	"github.com/iNamik/go_bst/finder"
+	"github.com/iNamik/go_bst/simple"
+	"github.com/iNamik/go_bst/walker"
+	"github.com/iNamik/go_cmp"
+)
+
+/**********************************************************************
+ ** Test Functions
+ **********************************************************************/
+
+// Test_Algebra
+func Test_Algebra(t *testing.T) {
+	for name, fnew := range factories {
+		t.Run(name, func(t *testing.T) {
+			a := newSet(fnew, 1, 2, 3, 4, 5)
+			b := newSet(fnew, 4, 5, 6, 7)
+			assertKeys(a.Union(b), []int{1, 2, 3, 4, 5, 6, 7}, t)
+			assertKeys(a.Intersection(b), []int{4, 5}, t)
+			assertKeys(a.Difference(b), []int{1, 2, 3}, t)
+			assertKeys(b.Difference(a), []int{6, 7}, t)
+			assertKeys(a.SymmetricDifference(b), []int{1, 2, 3, 6, 7}, t)
+			// The operands are unchanged
+			assertKeys(a, []int{1, 2, 3, 4, 5}, t)
+			assertKeys(b, []int{4, 5, 6, 7}, t)
+		})
+	}
+}
+
+// Test_Algebra_Empty
+func Test_Algebra_Empty(t *testing.T) {
+	fnew := factories["simple"]
+	a, e := newSet(fnew, 1, 2), newSet(fnew)
+	assertKeys(a.Union(e), []int{1, 2}, t)
+	assertKeys(e.Union(a), []int{1, 2}, t)
+	assertKeys(a.Intersection(e), []int{}, t)
+	assertKeys(a.Difference(e), []int{1, 2}, t)
+	assertKeys(e.Difference(a), []int{}, t)
+	assertKeys(e.SymmetricDifference(a), []int{1, 2}, t)
+	if !e.IsSubset(a) || a.IsSubset(e) {
+		t.Fatalf("IsSubset() returned the wrong result for an empty set")
+	}
+}
+
+// Test_Algebra_Random compares the set algebra against maps
+func Test_Algebra_Random(t *testing.T) {
+	const SIZE = 1000
+	fnew := factories["simple"]
+	a, b := newSet(fnew), newSet(fnew)
+	ma, mb := map[int]bool{}, map[int]bool{}
+	for i := 0; i < SIZE/2; i++ {
+		ka, kb := random.Intn(SIZE), random.Intn(SIZE)
+		a.Add(ka)
+		b.Add(kb)
+		ma[ka], mb[kb] = true, true
+	}
+	// expected returns the keys 0..SIZE-1 for which f returns true
+	expected := func(f func(k int) bool) []int {
+		keys := []int{}
+		for k := 0; k < SIZE; k++ {
+			if f(k) {
+				keys = append(keys, k)
+			}
+		}
+		return keys
+	}
+	assertKeys(a.Union(b), expected(func(k int) bool { return ma[k] || mb[k] }), t)
+	assertKeys(a.Intersection(b), expected(func(k int) bool { return ma[k] && mb[k] }), t)
+	assertKeys(a.Difference(b), expected(func(k int) bool { return ma[k] && !mb[k] }), t)
+	assertKeys(a.SymmetricDifference(b), expected(func(k int) bool { return ma[k] != mb[k] }), t)
+	// Results are balanced, even though simple trees do not balance themselves
+	u := a.Union(b).Tree()
+	if err := u.(bst.I_Validate).Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if h := height(u.(simple.T)); h > 11 { // 10 levels hold 1023 keys
+		t.Fatalf("Union() returned a tree of height %d", h)
+	}
+}
+
+// height returns the height of r, by finding every key
+func height(r simple.T) int {
+	var keys []interface{}
+	walker.ForeachMin(r, func(key interface{}, _ interface{}) {
+		keys = append(keys, key)
+	})
+	h := 0
+	for _, key := range keys {
+		d := 0
+		r.Find(func(n finder.Node) finder.Action {
+			d++
+			switch n.Cmp(key, n.Key()) {
+			case cmp.LT:
+				return finder.LEFT
+			case cmp.GT:
+				return finder.RIGHT
+			}
+			return finder.FOUND
+		})
+		if d > h {
+			h = d
+		}
+	}
+	return h
+}
+
+// Test_IsSubset
+func Test_IsSubset(t *testing.T) {
+	for name, fnew := range factories {
+		t.Run(name, func(t *testing.T) {
+			a := newSet(fnew, 2, 4)
+			b := newSet(fnew, 1, 2, 3, 4)
+			if !a.IsSubset(b) {
+				t.Fatalf("IsSubset() returned false for a subset")
+			}
+			if b.IsSubset(a) {
+				t.Fatalf("IsSubset() returned true for a superset")
+			}
+			if !a.IsSubset(a) {
+				t.Fatalf("IsSubset() returned false for the same set")
+			}
+		})
+	}
+}
+
+// Test_Algebra_Comparators confirms that the set algebra uses the
+// comparator of the set it is called on, even if other orders its keys
+// differently
+func Test_Algebra_Comparators(t *testing.T) {
+	fnew := factories["simple"]
+	reverse := func(a interface{}, b interface{}) int { return cmp.F_int(b, a) }
+	a := newSet(fnew, 1, 2, 3, 4, 5)
+	b := New(reverse, fnew)
+	for _, k := range []int{4, 5, 6, 7} {
+		b.Add(k)
+	}
+	assertKeys(b, []int{7, 6, 5, 4}, t)
+	assertKeys(a.Union(b), []int{1, 2, 3, 4, 5, 6, 7}, t)
+	assertKeys(a.Intersection(b), []int{4, 5}, t)
+	assertKeys(a.Difference(b), []int{1, 2, 3}, t)
+	assertKeys(a.SymmetricDifference(b), []int{1, 2, 3, 6, 7}, t)
+	assertKeys(b.Union(a), []int{7, 6, 5, 4, 3, 2, 1}, t)
+	assertKeys(b.Difference(a), []int{7, 6}, t)
+	if !newSet(fnew, 4, 6).IsSubset(b) || a.IsSubset(b) {
+		t.Fatalf("IsSubset() returned the wrong result for a set with a different comparator")
+	}
+	// Keys that are distinct in other, but equal by the receiver's comparator, count once
+	tens := func(a interface{}, b interface{}) int { return cmp.F_int(a.(int)/10, b.(int)/10) }
+	c := New(tens, fnew)
+	c.Add(10)
+	d := newSet(fnew, 11, 12, 25)
+	assertKeys(c.Union(d), []int{10, 25}, t)
+	assertKeys(c.SymmetricDifference(d), []int{25}, t)
+	if !c.IsSubset(d) {
+		t.Fatalf("IsSubset() returned the wrong result for a set with a coarser comparator")
+	}
+}
@@ -0,0 +1,72 @@
+package set
+
+import (
+	"math/rand"
+	"testing"
+)
+
+import (
+	"github.com/iNamik/go_bst"
+	"github.com/iNamik/go_bst/avl"
+	"github.com/iNamik/go_bst/simple"
+	"github.com/iNamik/go_bst/walker"
+	"github.com/iNamik/go_cmp"
+)
+
+/**********************************************************************
+ ** Test Data
+ **********************************************************************/
+
+// SEED keeps the tests repeatable
+const SEED = 1
+
+// random
+var random = rand.New(rand.NewSource(SEED))
+
+// walkerOnly hides every method of a tree except those of set.I,
+// so that the fallbacks for Len, Floor and Ceiling are used
+type walkerOnly struct {
+	bst.T
+	walker.I
+}
+
+// factories are the trees that the tests store sets in
+var factories = map[string]F_New{
+	"simple": func(fcmp cmp.F) I { return simple.New(fcmp) },
+	"avl":    func(fcmp cmp.F) I { return avl.New(fcmp) },
+	"walker": func(fcmp cmp.F) I { r := simple.New(fcmp); return walkerOnly{r, r} },
+}
+
+/**********************************************************************
+ ** Assert Functions
+ **********************************************************************/
+
+// assertKeys confirms that s holds exactly keys, in ascending order
+func assertKeys(s T, keys []int, t *testing.T) {
+	if s.Len() != len(keys) {
+		t.Fatalf("Len() returned %d instead of %d", s.Len(), len(keys))
+	}
+	i := 0
+	s.ForeachMin(func(key interface{}) {
+		if i >= len(keys) || key != keys[i] {
+			t.Fatalf("ForeachMin() visited %v at index %d of %v", key, i, keys)
+		}
+		i++
+	})
+	if i != len(keys) {
+		t.Fatalf("ForeachMin() visited %d keys instead of %d", i, len(keys))
+	}
+}
+
+/**********************************************************************
+ ** Helper Functions
+ **********************************************************************/
+
+// newSet returns a set, stored in a tree created by fnew, holding keys
+func newSet(fnew F_New, keys ...int) T {
+	s := New(cmp.F_int, fnew)
+	for _, k := range keys {
+		s.Add(k)
+	}
+	return s
+}
@@ -0,0 +1,64 @@
+/*
+
+Package set provides an ordered set, stored in any tree that
+implements bst.T and walker.I, such as the trees of the simple,
+avl and redblack packages.
+
+Keys are stored with nil values.  A set is created with the
+function that creates its tree:
+
+	s := set.New(cmp.F_int, func(fcmp cmp.F) set.I { return avl.New(fcmp) })
+
+
+Set Methods
+-----------
+
+ * Add
+ * Contains
+ * Delete
+ * Len        (uses bst.I_Size if the tree implements it)
+ * Min
+ * Max
+ * Floor      (uses finder.LowerBound if the tree implements finder.I, otherwise walker.LowerBound)
+ * Ceiling    (uses finder.UpperBound if the tree implements finder.I, otherwise walker.UpperBound)
+ * ForeachMin
+ * ForeachMax
+
+
+Set Algebra
+-----------
+
+ * Union
+ * Intersection
+ * Difference
+ * SymmetricDifference
+ * IsSubset
+
+Each method walks both sets once, collecting their keys, before
+merging them, so a set can be combined with itself, and runs in
+O(n + m) plus the cost of building the result.
+
+Keys are compared using the comparator of the set the method is
+called on.  If the other set orders its keys differently, its keys
+are first sorted by that comparator, which costs O(m log m), and
+keys that it considers equal are counted once.
+
+The resulting sets are created with the same function as the set
+the method is called on.  Keys are inserted median first, so that
+the result is balanced even when its tree does not balance itself.
+
+
+License
+-------
+
+This package is released under the MIT License.
+See included file 'LICENSE' for more details.
+
+
+Contributors
+------------
+
+David Farell <DavidPFarrell@yahoo.com>
+
+*/
+package set
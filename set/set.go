@@ -0,0 +1,165 @@
+package set
+
+import (
+	"github.com/iNamik/go_bst"
+	"github.com/iNamik/go_bst/finder"
+	"github.com/iNamik/go_bst/walker"
+	"github.com/iNamik/go_cmp"
+)
+
+/**********************************************************************
+ ** Types & Interfaces
+ **********************************************************************/
+
+// I specifies the tree a set is stored in
+type I interface {
+	bst.T
+	walker.I
+}
+
+// F_New creates a new, empty tree whose keys are ordered by fcmp
+type F_New func(fcmp cmp.F) I
+
+// F_Visit defines a function for visiting the keys of a set in sequential order
+type F_Visit func(key interface{})
+
+// T
+type T interface {
+	// Add adds key to the set, returning true if it was not already in the set
+	Add(key interface{}) (added bool)
+	// Contains returns true if key is in the set
+	Contains(key interface{}) bool
+	// Delete removes key from the set, returning true if it was in the set
+	Delete(key interface{}) (deleted bool)
+	// Len returns the number of keys in the set
+	Len() int
+	// Min returns the least key in the set
+	Min() (key interface{}, found bool)
+	// Max returns the greatest key in the set
+	Max() (key interface{}, found bool)
+	// Floor returns the greatest key that is less than or equal to key
+	Floor(key interface{}) (floor interface{}, found bool)
+	// Ceiling returns the least key that is greater than or equal to key
+	Ceiling(key interface{}) (ceiling interface{}, found bool)
+	// ForeachMin visits every key in ascending order
+	ForeachMin(f F_Visit)
+	// ForeachMax visits every key in descending order
+	ForeachMax(f F_Visit)
+	// Union returns a new set of the keys in either set.
+	// The set algebra compares keys using this set's comparator,
+	// even if other was created with a different one.
+	Union(other T) T
+	// Intersection returns a new set of the keys in both sets
+	Intersection(other T) T
+	// Difference returns a new set of the keys in this set but not in other
+	Difference(other T) T
+	// SymmetricDifference returns a new set of the keys in exactly one of the sets
+	SymmetricDifference(other T) T
+	// IsSubset returns true if every key in this set is also in other
+	IsSubset(other T) bool
+	// Tree returns the tree the set is stored in
+	Tree() I
+}
+
+// set
+type set struct {
+	i    I
+	fcmp cmp.F
+	fnew F_New
+}
+
+/**********************************************************************
+ ** Public Functions
+ **********************************************************************/
+
+// New creates an empty set, stored in a tree created by fnew.
+// Sets created by the set algebra methods are also created by fnew.
+func New(fcmp cmp.F, fnew F_New) T {
+	return &set{i: fnew(fcmp), fcmp: fcmp, fnew: fnew}
+}
+
+// set::Add
+func (s *set) Add(key interface{}) bool {
+	return !s.i.ReplaceOrInsert(key, nil)
+}
+
+// set::Contains
+func (s *set) Contains(key interface{}) bool {
+	_, found := s.i.Get(key)
+	return found
+}
+
+// set::Delete
+func (s *set) Delete(key interface{}) bool {
+	return s.i.Remove(key)
+}
+
+// set::Len uses the tree's Size if it implements bst.I_Size,
+// otherwise counting the keys by walking the tree
+func (s *set) Len() int {
+	if i, ok := s.i.(bst.I_Size); ok {
+		return i.Size()
+	}
+	size := 0
+	walker.ForeachMin(s.i, func(_ interface{}, _ interface{}) {
+		size++
+	})
+	return size
+}
+
+// set::Min
+func (s *set) Min() (interface{}, bool) {
+	key, _, found := walker.Min(s.i)
+	return key, found
+}
+
+// set::Max
+func (s *set) Max() (interface{}, bool) {
+	key, _, found := walker.Max(s.i)
+	return key, found
+}
+
+// set::Floor uses finder.LowerBound if the tree implements finder.I,
+// otherwise walker.LowerBound
+func (s *set) Floor(key interface{}) (interface{}, bool) {
+	var floor interface{}
+	var found bool
+	if i, ok := s.i.(finder.I); ok {
+		floor, _, found = finder.LowerBound(i, key)
+	} else {
+		floor, _, found = walker.LowerBound(s.i, key)
+	}
+	return floor, found
+}
+
+// set::Ceiling uses finder.UpperBound if the tree implements finder.I,
+// otherwise walker.UpperBound
+func (s *set) Ceiling(key interface{}) (interface{}, bool) {
+	var ceiling interface{}
+	var found bool
+	if i, ok := s.i.(finder.I); ok {
+		ceiling, _, found = finder.UpperBound(i, key)
+	} else {
+		ceiling, _, found = walker.UpperBound(s.i, key)
+	}
+	return ceiling, found
+}
+
+// set::ForeachMin
+func (s *set) ForeachMin(f F_Visit) {
+	walker.ForeachMin(s.i, func(key interface{}, _ interface{}) {
+		f(key)
+	})
+}
+
+// set::ForeachMax
+func (s *set) ForeachMax(f F_Visit) {
+	walker.ForeachMax(s.i, func(key interface{}, _ interface{}) {
+		f(key)
+	})
+}
+
+// set::Tree
+func (s *set) Tree() I {
+	return s.i
+}
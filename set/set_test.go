@@ -0,0 +1,82 @@
+package set
+
+import (
+	"testing"
+)
+
+/**********************************************************************
+ ** Test Functions
+ **********************************************************************/
+
+// Test_Set
+func Test_Set(t *testing.T) {
+	for name, fnew := range factories {
+		t.Run(name, func(t *testing.T) {
+			s := newSet(fnew)
+			if _, found := s.Min(); found {
+				t.Fatalf("Min() found a key in an empty set")
+			}
+			for _, k := range []int{5, 1, 9, 3, 7} {
+				if !s.Add(k) {
+					t.Fatalf("Add(%d) returned false for a new key", k)
+				}
+			}
+			if s.Add(5) {
+				t.Fatalf("Add(5) returned true for an existing key")
+			}
+			assertKeys(s, []int{1, 3, 5, 7, 9}, t)
+			if !s.Contains(3) || s.Contains(4) {
+				t.Fatalf("Contains() returned the wrong result")
+			}
+			if !s.Delete(3) || s.Delete(3) {
+				t.Fatalf("Delete(3) did not delete the key exactly once")
+			}
+			assertKeys(s, []int{1, 5, 7, 9}, t)
+			if k, found := s.Min(); k != 1 || !found {
+				t.Fatalf("Min() returned (%v, %v)", k, found)
+			}
+			if k, found := s.Max(); k != 9 || !found {
+				t.Fatalf("Max() returned (%v, %v)", k, found)
+			}
+			var keys []int
+			s.ForeachMax(func(key interface{}) { keys = append(keys, key.(int)) })
+			if len(keys) != 4 || keys[0] != 9 || keys[3] != 1 {
+				t.Fatalf("ForeachMax() visited %v", keys)
+			}
+		})
+	}
+}
+
+// Test_FloorCeiling
+func Test_FloorCeiling(t *testing.T) {
+	for name, fnew := range factories {
+		t.Run(name, func(t *testing.T) {
+			s := newSet(fnew, 10, 20, 30)
+			for _, test := range []struct {
+				key            int
+				floor, ceiling interface{}
+			}{
+				{5, nil, 10},
+				{10, 10, 10},
+				{15, 10, 20},
+				{30, 30, 30},
+				{35, 30, nil},
+			} {
+				if floor, found := s.Floor(test.key); floor != test.floor || found != (test.floor != nil) {
+					t.Fatalf("Floor(%d) returned (%v, %v) instead of %v", test.key, floor, found, test.floor)
+				}
+				if ceiling, found := s.Ceiling(test.key); ceiling != test.ceiling || found != (test.ceiling != nil) {
+					t.Fatalf("Ceiling(%d) returned (%v, %v) instead of %v", test.key, ceiling, found, test.ceiling)
+				}
+			}
+		})
+	}
+}
+
+// Test_Tree
+func Test_Tree(t *testing.T) {
+	s := newSet(factories["simple"], 1)
+	if value, found := s.Tree().Get(1); value != nil || !found {
+		t.Fatalf("Tree().Get(1) returned (%v, %v) instead of (%v, %v)", value, found, nil, true)
+	}
+}
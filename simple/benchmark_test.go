@@ -0,0 +1,104 @@
+package simple
+
+import (
+	"testing"
+)
+
+import (
+	"github.com/iNamik/go_bst/walker"
+	"github.com/iNamik/go_cmp"
+)
+
+/**********************************************************************
+ ** Helper Functions
+ **********************************************************************/
+
+// BENCH_SIZE is the number of keys in the trees used by the benchmarks
+const BENCH_SIZE = 10000
+
+// benchTree returns a tree created by fnew with keys 0..BENCH_SIZE-1 inserted in random order
+func benchTree(fnew func(cmp.F) T) T {
+	r := fnew(cmp.F_int)
+	for _, k := range benchKeys() {
+		r.ReplaceOrInsert(k, k)
+	}
+	return r
+}
+
+// benchKeys returns the keys 0..BENCH_SIZE-1 in a fixed, random-looking order
+func benchKeys() []int {
+	keys := make([]int, BENCH_SIZE)
+	for i := range keys {
+		keys[i] = (i * 7919) % BENCH_SIZE // 7919 is prime, so this is a permutation
+	}
+	return keys
+}
+
+// benchReplaceOrInsert
+func benchReplaceOrInsert(b *testing.B, fnew func(cmp.F) T) {
+	keys := benchKeys()
+	r := fnew(cmp.F_int)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		k := keys[i%BENCH_SIZE]
+		r.ReplaceOrInsert(k, k)
+	}
+}
+
+// benchGet
+func benchGet(b *testing.B, fnew func(cmp.F) T) {
+	keys := benchKeys()
+	r := benchTree(fnew)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		r.Get(keys[i%BENCH_SIZE])
+	}
+}
+
+// benchWalk
+func benchWalk(b *testing.B, fnew func(cmp.F) T) {
+	r := benchTree(fnew)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		walker.ForeachMin(r, func(_ interface{}, _ interface{}) {})
+	}
+}
+
+/**********************************************************************
+ ** Benchmark Functions
+ **********************************************************************/
+
+// Benchmark_ReplaceOrInsert_Locked
+func Benchmark_ReplaceOrInsert_Locked(b *testing.B) {
+	benchReplaceOrInsert(b, New)
+}
+
+// Benchmark_ReplaceOrInsert_Unlocked
+func Benchmark_ReplaceOrInsert_Unlocked(b *testing.B) {
+	benchReplaceOrInsert(b, NewUnsynchronized)
+}
+
+// Benchmark_Get_Locked
+func Benchmark_Get_Locked(b *testing.B) {
+	benchGet(b, New)
+}
+
+// Benchmark_Get_Unlocked
+func Benchmark_Get_Unlocked(b *testing.B) {
+	benchGet(b, NewUnsynchronized)
+}
+
+// Benchmark_Walk_Locked
+func Benchmark_Walk_Locked(b *testing.B) {
+	benchWalk(b, New)
+}
+
+// Benchmark_Walk_Unlocked
+func Benchmark_Walk_Unlocked(b *testing.B) {
+	benchWalk(b, NewUnsynchronized)
+}
+
+// Benchmark_Get_ReentrancyDetector
+func Benchmark_Get_ReentrancyDetector(b *testing.B) {
+	benchGet(b, func(fcmp cmp.F) T { return NewWithLocker(fcmp, NewReentrancyDetector()) })
+}
@@ -0,0 +1,43 @@
+package simple
+
+import (
+	"testing"
+)
+
+import (
+	"github.com/iNamik/go_bst"
+	"github.com/iNamik/go_bst/bsttest"
+	"github.com/iNamik/go_cmp"
+)
+
+/**********************************************************************
+ ** Conformance Tests
+ **********************************************************************/
+
+// Test_BSTTest_T
+func Test_BSTTest_T(t *testing.T) {
+	bsttest.RunT(t, func(fcmp cmp.F) bst.T { return New(fcmp) })
+}
+
+// Test_BSTTest_Finder
+func Test_BSTTest_Finder(t *testing.T) {
+	bsttest.RunFinder(t, func(fcmp cmp.F) bsttest.Finder { return New(fcmp) })
+}
+
+// Test_BSTTest_Visitor
+func Test_BSTTest_Visitor(t *testing.T) {
+	bsttest.RunVisitor(t, func(fcmp cmp.F) bsttest.Visitor { return New(fcmp) })
+}
+
+// Test_BSTTest_Walker
+func Test_BSTTest_Walker(t *testing.T) {
+	bsttest.RunWalker(t, func(fcmp cmp.F) bsttest.Walker { return New(fcmp) })
+}
+
+// Test_BSTTest_Unsynchronized
+func Test_BSTTest_Unsynchronized(t *testing.T) {
+	bsttest.RunT(t, func(fcmp cmp.F) bst.T { return NewUnsynchronized(fcmp) })
+	bsttest.RunFinder(t, func(fcmp cmp.F) bsttest.Finder { return NewUnsynchronized(fcmp) })
+	bsttest.RunVisitor(t, func(fcmp cmp.F) bsttest.Visitor { return NewUnsynchronized(fcmp) })
+	bsttest.RunWalker(t, func(fcmp cmp.F) bsttest.Walker { return NewUnsynchronized(fcmp) })
+}
@@ -0,0 +1,85 @@
+package simple
+
+import (
+	"sync"
+)
+
+import (
+	"github.com/iNamik/go_bst/finder"
+	"github.com/iNamik/go_bst/walker"
+)
+
+// snapshot is a read-only view of a private, unlocked tree
+type snapshot struct{ t *tree }
+
+// tree::Clone shares every node with t, so the clone has the same shape
+// and removal toggle as t.  Both t and the clone move to new generations,
+// so each copies any shared node before modifying it.
+// The clone is guarded by a new Locker of the same kind as t's, if t's
+// Locker implements I_NewLocker (as the Lockers used by NewUnsynchronized
+// and NewReentrancyDetector do), otherwise by a new sync.RWMutex.
+func (t *tree) Clone() T {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	var mutex Locker = &sync.RWMutex{}
+	if l, ok := t.mutex.(I_NewLocker); ok {
+		mutex = l.NewLocker()
+	}
+	t.gen = nextGen()
+	return &tree{mutex: mutex, root: t.root, fcmp: t.fcmp, left: t.left, size: t.size, gen: nextGen()}
+}
+
+// tree::Snapshot shares every node with t, and moves t to a new
+// generation, so t copies any shared node before modifying it
+func (t *tree) Snapshot() Snapshot {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.gen = nextGen()
+	// Nothing modifies the snapshot, so it needs no lock, and no generation of its own
+	return &snapshot{&tree{mutex: noLocker{}, root: t.root, fcmp: t.fcmp, left: t.left, size: t.size, gen: 0}}
+}
+
+// snapshot::Empty
+func (s *snapshot) Empty() bool {
+	return s.t.Empty()
+}
+
+// snapshot::Size
+func (s *snapshot) Size() int {
+	return s.t.Size()
+}
+
+// snapshot::Get
+func (s *snapshot) Get(key interface{}) (interface{}, bool) {
+	return s.t.Get(key)
+}
+
+// snapshot::Find
+func (s *snapshot) Find(f finder.F) (interface{}, interface{}, bool) {
+	return s.t.Find(f)
+}
+
+// snapshot::Walk
+func (s *snapshot) Walk(f walker.F) {
+	s.t.Walk(f)
+}
+
+// snapshot::Rank
+func (s *snapshot) Rank(key interface{}) int {
+	return s.t.Rank(key)
+}
+
+// snapshot::Select
+func (s *snapshot) Select(i int) (interface{}, interface{}, bool) {
+	return s.t.Select(i)
+}
+
+// snapshot::Min
+func (s *snapshot) Min() (interface{}, interface{}, bool) {
+	return s.t.Min()
+}
+
+// snapshot::Max
+func (s *snapshot) Max() (interface{}, interface{}, bool) {
+	return s.t.Max()
+}
@@ -0,0 +1,309 @@
+package simple
+
+import (
+	"math/rand"
+	"sync"
+	"testing"
+)
+
+import (
+	"github.com/iNamik/go_bst"
+	"github.com/iNamik/go_bst/finder"
+	"github.com/iNamik/go_bst/visitor"
+	"github.com/iNamik/go_bst/walker"
+	"github.com/iNamik/go_cmp"
+)
+
+/**********************************************************************
+ ** Assert Functions
+ **********************************************************************/
+
+// assertSameShape confirms that the subtrees rooted at a and b have the
+// same shape, keys, values and sizes
+func assertSameShape(a *node, b *node, t *testing.T) {
+	if a == nil || b == nil {
+		if a != b {
+			t.Fatalf("shapes differ: %v vs %v", a, b)
+		}
+		return
+	}
+	if a.key != b.key || a.value != b.value || a.size != b.size {
+		t.Fatalf("nodes differ: (%v, %v, %d) vs (%v, %v, %d)", a.key, a.value, a.size, b.key, b.value, b.size)
+	}
+	assertSameShape(a.left, b.left, t)
+	assertSameShape(a.right, b.right, t)
+}
+
+// assertKeys confirms that f holds exactly the keys from..to-1, with value = key
+func assertKeys(f walker.I, from int, to int, t *testing.T) {
+	k := from
+	walker.ForeachMin(f, func(key interface{}, value interface{}) {
+		if key != k || value != k {
+			t.Fatalf("walked (%v, %v) instead of (%d, %d)", key, value, k, k)
+		}
+		k++
+	})
+	if k != to {
+		t.Fatalf("walk ended at %d instead of %d", k, to)
+	}
+}
+
+/**********************************************************************
+ ** Helper Functions
+ **********************************************************************/
+
+// countNodes returns the number of distinct nodes reachable from roots
+func countNodes(roots ...*node) int {
+	seen := map[*node]bool{}
+	var visit func(h *node)
+	visit = func(h *node) {
+		if h != nil && !seen[h] {
+			seen[h] = true
+			visit(h.left)
+			visit(h.right)
+		}
+	}
+	for _, h := range roots {
+		visit(h)
+	}
+	return len(seen)
+}
+
+// depth returns the number of nodes on the path from h to key
+func depth(h *node, key int) int {
+	d := 0
+	for h != nil {
+		d++
+		switch cmp.F_int(key, h.key) {
+		case cmp.LT:
+			h = h.left
+		case cmp.GT:
+			h = h.right
+		default:
+			return d
+		}
+	}
+	return d
+}
+
+/**********************************************************************
+ ** Test Functions
+ **********************************************************************/
+
+// Test_Clone
+func Test_Clone(t *testing.T) {
+	r := randomTree(1000)
+	for k := 0; k < 100; k += 3 { // Move the toggle away from its initial state
+		r.Remove(k)
+	}
+	c := r.Clone()
+	assertSameShape(r.(*tree).root, c.(*tree).root, t)
+	if r.(*tree).left != c.(*tree).left || r.Size() != c.Size() {
+		t.Fatalf("Clone() did not copy the removal toggle and size")
+	}
+	// The same removals produce the same shapes
+	for k := 100; k < 500; k += 7 {
+		r.Remove(k)
+		c.Remove(k)
+	}
+	assertSameShape(r.(*tree).root, c.(*tree).root, t)
+	assertValidate(c, "", t)
+}
+
+// Test_Clone_Independent
+func Test_Clone_Independent(t *testing.T) {
+	r := randomTree(100)
+	c := r.Clone()
+	c.ReplaceOrInsert(1000, 1000)
+	r.Remove(50)
+	if _, found := r.Get(1000); found {
+		t.Fatalf("insert into the clone changed the tree")
+	}
+	if _, found := c.Get(50); !found {
+		t.Fatalf("remove from the tree changed the clone")
+	}
+	assertValidate(r, "", t)
+	assertValidate(c, "", t)
+}
+
+// Test_Clone_Unsynchronized
+func Test_Clone_Unsynchronized(t *testing.T) {
+	r := NewUnsynchronized(cmp.F_int)
+	if _, ok := r.Clone().(*tree).mutex.(noLocker); !ok {
+		t.Fatalf("Clone() of an unsynchronized tree added a lock")
+	}
+	if _, ok := New(cmp.F_int).Clone().(*tree).mutex.(noLocker); ok {
+		t.Fatalf("Clone() of a synchronized tree removed the lock")
+	}
+}
+
+// Test_Clone_ReentrancyDetector confirms that a clone gets its own reentrancy detector
+func Test_Clone_ReentrancyDetector(t *testing.T) {
+	r := detectingTree(10)
+	c := r.Clone()
+	d, ok := c.(*tree).mutex.(*reentrancyDetector)
+	if !ok || d == r.(*tree).mutex {
+		t.Fatalf("Clone() is guarded by %T instead of a new *reentrancyDetector", c.(*tree).mutex)
+	}
+	assertReentrancyPanic("Find() calling Get()", func() {
+		c.Find(func(finder.Node) finder.Action {
+			c.Get(key1)
+			return finder.FOUND
+		})
+	}, t)
+	// The clone's lock is independent of the tree's
+	r.Find(func(finder.Node) finder.Action {
+		c.ReplaceOrInsert(key1, key2)
+		return finder.FOUND
+	})
+	assertGet(c, key1, key2, true, t)
+}
+
+// otherLocker is a Locker that does not implement I_NewLocker
+type otherLocker struct{ sync.RWMutex }
+
+// Test_Clone_OtherLocker confirms that a clone of a tree guarded by a Locker
+// that does not implement I_NewLocker is guarded by a sync.RWMutex
+func Test_Clone_OtherLocker(t *testing.T) {
+	r := NewWithLocker(cmp.F_int, &otherLocker{})
+	if _, ok := r.Clone().(*tree).mutex.(*sync.RWMutex); !ok {
+		t.Fatalf("Clone() is guarded by %T instead of a *sync.RWMutex", r.Clone().(*tree).mutex)
+	}
+}
+
+// Test_Snapshot
+func Test_Snapshot(t *testing.T) {
+	const SIZE = 100
+	r := New(cmp.F_int)
+	for k := 0; k < SIZE; k++ {
+		r.ReplaceOrInsert(k, k)
+	}
+	s := r.Snapshot()
+	for k := 0; k < SIZE; k++ {
+		r.Remove(k)
+		r.ReplaceOrInsert(k+SIZE, k+SIZE)
+	}
+	assertKeys(s, 0, SIZE, t)
+	assertKeys(r, SIZE, SIZE*2, t)
+	if s.Size() != SIZE || s.Empty() {
+		t.Fatalf("Size() returned %d", s.Size())
+	}
+	if k, _, _ := finder.LowerBound(s, SIZE); k != SIZE-1 {
+		t.Fatalf("LowerBound() returned %v instead of %d", k, SIZE-1)
+	}
+	if k, _, _ := s.Select(10); k != 10 || s.Rank(10) != 10 {
+		t.Fatalf("Select(10) returned %v", k)
+	}
+	if _, ok := s.(bst.I_ReplaceOrInsert); ok {
+		t.Fatalf("Snapshot() can be modified")
+	}
+}
+
+// Test_Snapshot_Concurrent reads a snapshot while the tree keeps changing.
+// Run with -race to confirm that the snapshot shares nothing with the tree.
+func Test_Snapshot_Concurrent(t *testing.T) {
+	const SIZE = 1000
+	r := randomTree(SIZE)
+	s := r.Snapshot()
+	wg := &sync.WaitGroup{}
+	wg.Add(1)
+	sums := make([]int, 10) // Sum of the values seen by each walk of the snapshot
+	go func() {
+		defer wg.Done()
+		for i := range sums {
+			walker.ForeachMin(s, func(_ interface{}, value interface{}) { sums[i] += value.(int) })
+		}
+	}()
+	for k := 0; k < SIZE; k++ {
+		r.Visit(k, func(value interface{}, found bool) (interface{}, visitor.Action) {
+			return value.(int) + 1, visitor.REPLACE
+		})
+	}
+	wg.Wait()
+	for _, sum := range sums {
+		if sum != SIZE*(SIZE-1)/2 {
+			t.Fatalf("snapshot values summed to %d instead of %d", sum, SIZE*(SIZE-1)/2)
+		}
+	}
+}
+
+// Test_Snapshot_Sharing confirms that Snapshot copies nothing, and that
+// later changes to the tree copy only the nodes they touch
+func Test_Snapshot_Sharing(t *testing.T) {
+	const SIZE = 1000
+	r := randomTree(SIZE)
+	s := r.Snapshot()
+	root := s.(*snapshot).t.root
+	if root != r.(*tree).root {
+		t.Fatalf("Snapshot() copied the root")
+	}
+	get := func(_ interface{}, _ bool) (interface{}, visitor.Action) { return nil, visitor.GET }
+	// Nothing is copied by reads, or by changes that do not happen
+	r.Get(1)
+	r.Remove(SIZE)
+	r.Visit(1, get)
+	r.Visit(SIZE, get)
+	if r.(*tree).root != root {
+		t.Fatalf("the tree copied nodes without changing")
+	}
+	// Each change copies the path to the key, once
+	copied := 0
+	for k := 0; k < 10; k++ {
+		copied += depth(root, k)
+		r.ReplaceOrInsert(k, k)
+		r.ReplaceOrInsert(k, k) // Already copied, so modified in place
+	}
+	if count := countNodes(root, r.(*tree).root); count > SIZE+copied {
+		t.Fatalf("copied %d nodes instead of at most %d", count-SIZE, copied)
+	}
+	assertKeys(s, 0, SIZE, t)
+	assertValidate(r, "", t)
+}
+
+// Test_Clone_Sharing changes both a tree and its clones, confirming
+// that none of them sees the changes of the others
+func Test_Clone_Sharing(t *testing.T) {
+	const SIZE = 200
+	trees := []T{randomTree(SIZE)}
+	models := []map[int]int{{}}
+	for k := 0; k < SIZE; k++ {
+		models[0][k] = k
+	}
+	for i := 0; i < 2000; i++ {
+		j := rand.Intn(len(trees))
+		r, m := trees[j], models[j]
+		k := rand.Intn(SIZE)
+		switch rand.Intn(5) {
+		case 0:
+			c := r.Clone()
+			mc := map[int]int{}
+			for k, v := range m {
+				mc[k] = v
+			}
+			trees, models = append(trees, c), append(models, mc)
+		case 1:
+			r.Remove(k)
+			delete(m, k)
+		case 2:
+			visitor.Remove(r, k)
+			delete(m, k)
+		case 3:
+			visitor.ReplaceOrInsert(r, k, i)
+			m[k] = i
+		default:
+			r.ReplaceOrInsert(k, i)
+			m[k] = i
+		}
+	}
+	for j, r := range trees {
+		assertValidate(r, "", t)
+		if r.Size() != len(models[j]) {
+			t.Fatalf("tree %d has size %d instead of %d", j, r.Size(), len(models[j]))
+		}
+		for k, v := range models[j] {
+			if v_, found := r.Get(k); !found || v_ != v {
+				t.Fatalf("tree %d has (%v, %v) for key %d instead of (%v, %v)", j, v_, found, k, v, true)
+			}
+		}
+	}
+}
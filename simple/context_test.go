@@ -0,0 +1,73 @@
+package simple
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+import (
+	"github.com/iNamik/go_bst/walker"
+)
+
+/**********************************************************************
+ ** Test Functions
+ **********************************************************************/
+
+// Test_WalkContext_Canceled confirms that a canceled context stops
+// the walk before the first node
+func Test_WalkContext_Canceled(t *testing.T) {
+	r := randomTree(100)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := r.WalkContext(ctx, func(n walker.Node) walker.Action {
+		t.Fatalf("WalkContext() called f with a canceled context")
+		return walker.RETURN
+	})
+	if err != context.Canceled {
+		t.Fatalf("WalkContext() returned '%v' instead of '%v'", err, context.Canceled)
+	}
+}
+
+// Test_ForeachContext_Cancel confirms that canceling the context
+// during an iteration stops it at the next node
+func Test_ForeachContext_Cancel(t *testing.T) {
+	r := randomTree(100)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	count := 0
+	err := walker.ForeachMaxContext(ctx, r, func(key interface{}, value interface{}) {
+		if count++; count == 10 {
+			cancel()
+		}
+	})
+	if count != 10 || err != context.Canceled {
+		t.Fatalf("ForeachMaxContext() visited %d keys and returned '%v'", count, err)
+	}
+	// The tree has been unlocked
+	assertRemove(r, key1, true, t)
+	// A context that is never done visits every key
+	count = 0
+	err = walker.ForeachRangeContext(context.Background(), r, 10, 20, true, false, func(key interface{}, value interface{}) {
+		count++
+	})
+	if count != 10 || err != nil {
+		t.Fatalf("ForeachRangeContext() visited %d keys and returned '%v'", count, err)
+	}
+}
+
+// Test_ForeachContext_Deadline
+func Test_ForeachContext_Deadline(t *testing.T) {
+	r := randomTree(1000)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	count := 0
+	err := walker.ForeachMinContext(ctx, r, func(key interface{}, value interface{}) {
+		count++
+		time.Sleep(time.Millisecond)
+	})
+	if errors.Is(err, context.DeadlineExceeded) == false || count >= 1000 {
+		t.Fatalf("ForeachMinContext() visited %d keys and returned '%v'", count, err)
+	}
+}
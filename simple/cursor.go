@@ -0,0 +1,185 @@
+package simple
+
+import (
+	"github.com/iNamik/go_cmp"
+)
+
+// Cursor is a pull-style iterator over the keys of a tree, in order.
+//
+// A cursor does not hold the tree's lock between calls.  Instead, it
+// remembers the key it is positioned at, and each move (Seek, First,
+// Last, Next and Prev) descends from the root under the read lock, in
+// O(log n), to the nearest key in the requested direction.  So a
+// cursor is never invalidated by changes to the tree: Next moves to
+// the least key greater than the cursor's key, whether or not the
+// cursor's key is still in the tree, and sees keys inserted ahead of
+// it.  Key and Value return the key and value as they were when the
+// cursor moved to them.
+//
+// For iteration that does not see concurrent changes, use a cursor
+// from a Snapshot.
+//
+// A cursor is not safe for use by multiple goroutines.
+type Cursor interface {
+	// Seek moves to the least key greater than or equal to key
+	Seek(key interface{}) (valid bool)
+	// First moves to the minimum key
+	First() (valid bool)
+	// Last moves to the maximum key
+	Last() (valid bool)
+	// Next moves to the least key greater than the cursor's key
+	Next() (valid bool)
+	// Prev moves to the greatest key less than the cursor's key
+	Prev() (valid bool)
+	// Valid returns true if the cursor is positioned at a key.
+	// A cursor is invalid until it is first moved, after moving beyond
+	// either end of the tree, and after it is closed.
+	Valid() bool
+	// Key returns the cursor's key, or nil if the cursor is invalid
+	Key() interface{}
+	// Value returns the cursor's value, or nil if the cursor is invalid
+	Value() interface{}
+	// Close invalidates the cursor.  Moving a closed cursor panics.
+	Close()
+}
+
+// cursor
+type cursor struct {
+	t      *tree
+	key    interface{}
+	value  interface{}
+	valid  bool
+	closed bool
+}
+
+// tree::Cursor returns a new cursor, which is invalid until it is first moved
+func (t *tree) Cursor() Cursor {
+	return &cursor{t: t}
+}
+
+// snapshot::Cursor
+func (s *snapshot) Cursor() Cursor {
+	return s.t.Cursor()
+}
+
+// cursor::Seek
+func (c *cursor) Seek(key interface{}) bool {
+	return c.move(func(t *tree) *node { return ceiling(t.root, key, true, t.fcmp) })
+}
+
+// cursor::First
+func (c *cursor) First() bool {
+	return c.move(func(t *tree) *node {
+		if t.root == nil {
+			return nil
+		}
+		return min(t.root)
+	})
+}
+
+// cursor::Last
+func (c *cursor) Last() bool {
+	return c.move(func(t *tree) *node {
+		if t.root == nil {
+			return nil
+		}
+		return max(t.root)
+	})
+}
+
+// cursor::Next
+func (c *cursor) Next() bool {
+	if c.check(); c.valid == false {
+		return false
+	}
+	return c.move(func(t *tree) *node { return ceiling(t.root, c.key, false, t.fcmp) })
+}
+
+// cursor::Prev
+func (c *cursor) Prev() bool {
+	if c.check(); c.valid == false {
+		return false
+	}
+	return c.move(func(t *tree) *node { return floor(t.root, c.key, false, t.fcmp) })
+}
+
+// cursor::Valid
+func (c *cursor) Valid() bool {
+	return c.valid
+}
+
+// cursor::Key
+func (c *cursor) Key() interface{} {
+	return c.key
+}
+
+// cursor::Value
+func (c *cursor) Value() interface{} {
+	return c.value
+}
+
+// cursor::Close
+func (c *cursor) Close() {
+	c.key, c.value, c.valid, c.closed = nil, nil, false, true
+}
+
+// cursor::move positions the cursor at the node returned by f,
+// which is called with the tree read-locked
+func (c *cursor) move(f func(*tree) *node) bool {
+	c.check()
+	c.t.mutex.RLock()
+	defer c.t.mutex.RUnlock()
+	if h := f(c.t); h != nil {
+		c.key, c.value, c.valid = h.key, h.value, true
+	} else {
+		c.key, c.value, c.valid = nil, nil, false
+	}
+	return c.valid
+}
+
+// cursor::check panics if the cursor has been closed
+func (c *cursor) check() {
+	if c.closed {
+		panic("cursor has been closed")
+	}
+}
+
+// ceiling returns the node with the least key greater than key
+// (or equal to key, if inclusive), or nil if there is none
+func ceiling(h *node, key interface{}, inclusive bool, fcmp cmp.F) *node {
+	var found *node
+	for h != nil {
+		switch fcmp(key, h.key) {
+		case cmp.LT:
+			found, h = h, h.left
+		case cmp.GT:
+			h = h.right
+		default:
+			if inclusive {
+				return h
+			}
+			h = h.right
+		}
+	}
+	return found
+}
+
+// floor returns the node with the greatest key less than key
+// (or equal to key, if inclusive), or nil if there is none
+func floor(h *node, key interface{}, inclusive bool, fcmp cmp.F) *node {
+	var found *node
+	for h != nil {
+		switch fcmp(key, h.key) {
+		case cmp.GT:
+			found, h = h, h.right
+		case cmp.LT:
+			h = h.left
+		default:
+			if inclusive {
+				return h
+			}
+			h = h.left
+		}
+	}
+	return found
+}
@@ -0,0 +1,190 @@
+package simple
+
+import (
+	"sync"
+	"testing"
+)
+
+import (
+	"github.com/iNamik/go_cmp"
+)
+
+/**********************************************************************
+ ** Assert Functions
+ **********************************************************************/
+
+// assertCursor confirms the cursor's validity and position
+func assertCursor(c Cursor, valid bool, key interface{}, t *testing.T) {
+	if c.Valid() != valid || c.Key() != key || (valid && c.Value() != key) {
+		t.Fatalf("cursor is at (%v, %v, %v) instead of (%v, %v, %v)", c.Key(), c.Value(), c.Valid(), key, key, valid)
+	}
+}
+
+/**********************************************************************
+ ** Test Functions
+ **********************************************************************/
+
+// Test_Cursor_Empty
+func Test_Cursor_Empty(t *testing.T) {
+	c := New(cmp.F_int).Cursor()
+	assertCursor(c, false, nil, t)
+	if c.First() || c.Last() || c.Seek(key1) || c.Next() || c.Prev() {
+		t.Fatalf("cursor moved within an empty tree")
+	}
+}
+
+// Test_Cursor_Forward
+func Test_Cursor_Forward(t *testing.T) {
+	const COUNT = 1000
+	r := randomTree(COUNT)
+	c := r.Cursor()
+	i := 0
+	for valid := c.First(); valid; valid = c.Next() {
+		assertCursor(c, true, i, t)
+		i++
+	}
+	if i != COUNT {
+		t.Fatalf("cursor visited %d keys instead of %d", i, COUNT)
+	}
+	assertCursor(c, false, nil, t)
+}
+
+// Test_Cursor_Backward
+func Test_Cursor_Backward(t *testing.T) {
+	const COUNT = 1000
+	r := randomTree(COUNT)
+	c := r.Cursor()
+	i := COUNT
+	for valid := c.Last(); valid; valid = c.Prev() {
+		i--
+		assertCursor(c, true, i, t)
+	}
+	if i != 0 {
+		t.Fatalf("cursor visited %d keys instead of %d", COUNT-i, COUNT)
+	}
+}
+
+// Test_Cursor_Seek
+func Test_Cursor_Seek(t *testing.T) {
+	r := randomTreeDouble(100) // Even keys only
+	c := r.Cursor()
+	for k := -1; k < 200; k++ {
+		expected := k + k%2
+		if k < 0 {
+			expected = 0
+		}
+		if expected < 200 {
+			c.Seek(k)
+			assertCursor(c, true, expected, t)
+		} else if c.Seek(k) {
+			t.Fatalf("Seek(%d) found '%v'", k, c.Key())
+		}
+	}
+	c.Seek(50)
+	c.Prev()
+	assertCursor(c, true, 48, t)
+	c.Next()
+	c.Next()
+	assertCursor(c, true, 52, t)
+}
+
+// Test_Cursor_Modified confirms that a cursor re-seeks by its key
+// when the tree is changed between moves
+func Test_Cursor_Modified(t *testing.T) {
+	r := randomTreeDouble(10) // 0, 2 .. 18
+	c := r.Cursor()
+	c.Seek(4)
+	// Remove the cursor's key, and insert a key just ahead of it
+	assertRemove(r, 4, true, t)
+	assertReplaceOrInsert(r, 5, 5, false, t)
+	assertCursor(c, true, 4, t)
+	c.Next()
+	assertCursor(c, true, 5, t)
+	c.Prev()
+	assertCursor(c, true, 2, t)
+	// Remove everything ahead of the cursor
+	for k := 5; k < 20; k++ {
+		r.Remove(k)
+	}
+	if c.Next(); c.Next() {
+		t.Fatalf("Next() moved to '%v' in a tree without greater keys", c.Key())
+	}
+}
+
+// Test_Cursor_Snapshot confirms that a snapshot's cursor does not see changes
+func Test_Cursor_Snapshot(t *testing.T) {
+	r := randomTree(10)
+	c := r.Snapshot().Cursor()
+	c.First()
+	r.Remove(1)
+	r.ReplaceOrInsert(10, 10)
+	i := 0
+	for valid := c.Next(); valid; valid = c.Next() {
+		i++
+		assertCursor(c, true, i, t)
+	}
+	if i != 9 {
+		t.Fatalf("snapshot cursor ended at '%d' instead of '%d'", i, 9)
+	}
+}
+
+// Test_Cursor_Merge walks two cursors in step, as when merging two trees
+func Test_Cursor_Merge(t *testing.T) {
+	a, b := New(cmp.F_int), New(cmp.F_int)
+	for k := 0; k < 100; k++ {
+		if k%3 == 0 {
+			a.ReplaceOrInsert(k, k)
+		} else {
+			b.ReplaceOrInsert(k, k)
+		}
+	}
+	ca, cb := a.Cursor(), b.Cursor()
+	ca.First()
+	cb.First()
+	for i := 0; ca.Valid() || cb.Valid(); i++ {
+		c := ca
+		if !ca.Valid() || (cb.Valid() && cb.Key().(int) < ca.Key().(int)) {
+			c = cb
+		}
+		assertCursor(c, true, i, t)
+		c.Next()
+	}
+}
+
+// Test_Cursor_Close
+func Test_Cursor_Close(t *testing.T) {
+	r := randomTree(10)
+	c := r.Cursor()
+	c.First()
+	c.Close()
+	assertCursor(c, false, nil, t)
+	assertPanic(t, "cursor has been closed", func() { c.First() })
+	assertPanic(t, "cursor has been closed", func() { c.Next() })
+}
+
+// Test_Cursor_Concurrent moves a cursor while another goroutine changes
+// the tree.  Run with -race to confirm that moves hold the read lock.
+func Test_Cursor_Concurrent(t *testing.T) {
+	const COUNT = 1000
+	r := randomTreeDouble(COUNT)
+	wg := &sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for k := 1; k < COUNT*2; k += 2 {
+			r.ReplaceOrInsert(k, k)
+		}
+	}()
+	c := r.Cursor()
+	last := -1
+	for valid := c.First(); valid; valid = c.Next() {
+		if c.Key().(int) <= last {
+			t.Fatalf("cursor moved from '%d' to '%v'", last, c.Key())
+		}
+		last = c.Key().(int)
+	}
+	wg.Wait()
+	if last != COUNT*2-1 && last != COUNT*2-2 {
+		t.Fatalf("cursor ended at '%d'", last)
+	}
+}
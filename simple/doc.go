@@ -25,6 +25,8 @@ All of the extensible interfaces have been implemented:
  * Find  (see finder.T)
  * Visit (see visitor.T)
  * Walk  (see walker.T)
+ * FindE, VisitE and WalkE (see finder.FindE, visitor.VisitE and walker.WalkE)
+ * WalkContext (see walker.WalkContext)
 
 
 Additional BST Methods
@@ -32,9 +34,127 @@ Additional BST Methods
 
 The following additional BST methods have been implemented:
 
- * Size (see bst.I_Size)
- * Min  (see finder.I_Min)
- * Max  (see finder.I_Max)
+ * Size     (see bst.I_Size)
+ * Min      (see finder.I_Min)
+ * Max      (see finder.I_Max)
+ * Rank     (see bst.I_Rank)
+ * Select   (see bst.I_Select)
+ * Validate (see bst.I_Validate)
+ * Clone
+ * Snapshot
+ * Begin    (see simple.Txn)
+ * Cursor   (see simple.Cursor)
+
+
+Order Statistics
+----------------
+
+Each node stores the size of its subtree, which allows Rank
+and Select to run in O(height), without walking the tree.
+Sizes are kept correct by ReplaceOrInsert, Remove and Visit.
+
+
+Clones and Snapshots
+--------------------
+
+Clone returns an independent copy of the tree, with the same shape
+and removal toggle, so later changes to either tree do not affect
+the other.
+
+Snapshot returns a read-only view (see simple.Snapshot) of the tree
+as it is now, which satisfies finder.I and walker.I, so it can be
+handed to another goroutine, for reporting for example, while the
+tree keeps changing.
+
+Both run in O(1), by sharing every node with the tree.  Each node
+is tagged with the generation of the tree that may modify it in place.
+Clone and Snapshot move the tree (and the clone) to new generations,
+so that ReplaceOrInsert, Remove and Visit copy each shared node on the
+path they change, once, before modifying it.  Reads, and changes that
+do not happen (e.g. removing a key that is not found), copy nothing.
+
+
+Transactions
+------------
+
+Begin starts a transaction (see simple.Txn), which supports the
+same reads and changes as the tree.  Changes made through the
+transaction become visible together when it is committed, or not
+at all when it is rolled back:
+
+	x := t.Begin()
+	defer x.Rollback()
+	v, _ := x.Get(from)
+	x.Remove(from)
+	x.ReplaceOrInsert(to, v)
+	x.Commit()
+
+The transaction holds the tree's write lock until it ends, so the
+tree must not be used directly, even by the goroutine that began the
+transaction, until it is committed or rolled back.  Rollback does
+nothing once the transaction has ended, so deferring it, as above,
+releases the lock even if a panic prevents Commit.  Otherwise, using
+a transaction after it ends panics.
+
+Like a clone, a transaction shares every node with the tree, copying
+only the nodes it changes, so Begin, Commit and Rollback run in O(1).
+
+
+Cursors
+-------
+
+Cursor returns a pull-style iterator, which moves with Seek, First,
+Last, Next and Prev.  A cursor does not hold the lock between moves;
+it remembers its key, and each move descends from the root to the
+nearest key in the requested direction, in O(log n).  So changes to
+the tree never invalidate a cursor: it continues from its key,
+whether or not that key is still in the tree, seeing any keys
+inserted ahead of it.  A cursor from a Snapshot sees no changes.
+
+
+Locking
+-------
+
+New creates a tree guarded by a sync.RWMutex.  Read-only methods
+(Empty, Size, Get, Find, Walk, Min, Max, Rank, Select, Validate and
+Cursor moves) hold the read lock, so concurrent readers proceed in
+parallel, while ReplaceOrInsert, Remove and Visit hold the write lock.
+
+NewWithLocker creates a tree guarded by any Locker, such as one
+that records lock contention.  A clone of the tree is guarded by a
+new sync.RWMutex, unless the Locker implements I_NewLocker, in which
+case the clone is guarded by the Locker that it returns.
+
+NewUnsynchronized creates a tree that does no locking at all, for
+trees owned by a single goroutine.  This avoids the cost of taking
+and releasing the lock on every call, which matters most for calls
+that do little work, such as Get and ReplaceOrInsert on small trees.
+Walk takes the lock once per traversal, not once per node.
+
+
+Call-backs
+----------
+
+The lock is held while the Find, Visit and Walk call-backs run, so
+a call-back must not call back into the same tree from the same
+goroutine.  Such a call deadlocks, either always (when either call
+needs the write lock) or whenever a writer is waiting (when both
+calls only need the read lock).
+
+To find such calls, create the tree with NewReentrancyDetector:
+
+	t := simple.NewWithLocker(fcmp, simple.NewReentrancyDetector())
+
+which panics on a reentrant call instead of deadlocking.  It parses
+runtime.Stack to find the current goroutine's id on every lock and
+unlock, which makes each call more than 100 times slower (a Get takes
+microseconds instead of nanoseconds), so it must not be used in
+production; it is intended for debugging and tests.
+
+Find, Visit and Walk panic when a call-back returns an illegal action,
+or walks to a node that does not exist.  FindE, VisitE and WalkE report
+these, along with panics in the call-back, as errors instead, leaving
+the tree unchanged and unlocked.
 
 
 Leaning
@@ -62,6 +182,9 @@ use recursion:
 The remaining functions do not use recursion and can be
 considered efficient implementations.
 
+The linked package provides a variant that stores parent and
+neighbor links, and uses no recursion.
+
 
 License
 -------
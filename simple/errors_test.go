@@ -0,0 +1,45 @@
+package simple
+
+import (
+	"testing"
+)
+
+import (
+	"github.com/iNamik/go_bst/finder"
+	"github.com/iNamik/go_bst/visitor"
+	"github.com/iNamik/go_bst/walker"
+	"github.com/iNamik/go_cmp"
+)
+
+/**********************************************************************
+ ** Test Functions
+ **********************************************************************/
+
+// Test_Errors_Unlock confirms that FindE, VisitE and WalkE leave the tree
+// unchanged and unlocked when they report an error
+// (the errors themselves are tested in finder, visitor and walker)
+func Test_Errors_Unlock(t *testing.T) {
+	r := New(cmp.F_int)
+	r.ReplaceOrInsert(key1, key1)
+	if _, _, _, err := r.FindE(func(node finder.Node) finder.Action {
+		panic("oops")
+	}); err == nil {
+		t.Fatalf("FindE() returned no error")
+	}
+	if _, _, err := r.VisitE(key2, func(value interface{}, found bool) (interface{}, visitor.Action) {
+		return key2, visitor.REPLACE
+	}); err == nil {
+		t.Fatalf("VisitE() returned no error")
+	}
+	if err := r.WalkE(func(n walker.Node) walker.Action {
+		return walker.LEFT
+	}); err == nil {
+		t.Fatalf("WalkE() returned no error")
+	}
+	// The tree is unchanged, and can still be locked for writing
+	r.ReplaceOrInsert(key3, key3)
+	assertSize(r, 2, t)
+	assertGet(r, key1, key1, true, t)
+	assertGet(r, key2, nil, false, t)
+	assertBST(r, t)
+}
@@ -40,8 +40,8 @@ func (r *rnode) Cmp(a interface{}, b interface{}) int {
 
 // Find
 func (t *tree) Find(f finder.F) (key interface{}, value interface{}, found bool) {
-	t.mutex.Lock()
-	defer t.mutex.Unlock()
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
 	for h := t.root; h != nil; {
 		switch action := f(&rnode{n: h, fcmp: t.fcmp}); action {
 		case finder.LEFT:
@@ -59,3 +59,8 @@ func (t *tree) Find(f finder.F) (key interface{}, value interface{}, found bool)
 	}
 	return nil, nil, false
 }
+
+// tree::FindE (see finder.FindE)
+func (t *tree) FindE(f finder.F) (key interface{}, value interface{}, found bool, err error) {
+	return finder.FindE(t, f)
+}
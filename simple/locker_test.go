@@ -0,0 +1,158 @@
+package simple
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+import (
+	"github.com/iNamik/go_bst/finder"
+	"github.com/iNamik/go_bst/visitor"
+	"github.com/iNamik/go_bst/walker"
+	"github.com/iNamik/go_cmp"
+)
+
+/**********************************************************************
+ ** Helper Types
+ **********************************************************************/
+
+// countingLocker counts the read and write locks taken on a sync.RWMutex
+type countingLocker struct {
+	sync.RWMutex
+	reads  int
+	writes int
+}
+
+// countingLocker::Lock
+func (l *countingLocker) Lock() {
+	l.RWMutex.Lock()
+	l.writes++
+}
+
+// countingLocker::RLock
+func (l *countingLocker) RLock() {
+	l.RWMutex.RLock()
+	l.reads++ // Only one reader at a time in these tests
+}
+
+/**********************************************************************
+ ** Test Functions
+ **********************************************************************/
+
+// Test_Locker confirms that read-only methods take the read lock,
+// and that methods which may modify the tree take the write lock
+func Test_Locker(t *testing.T) {
+	l := &countingLocker{}
+	r := NewWithLocker(cmp.F_int, l)
+	get := func(_ interface{}, _ bool) (interface{}, visitor.Action) { return nil, visitor.GET }
+	for _, test := range []struct {
+		name  string
+		f     func()
+		write bool
+	}{
+		{"ReplaceOrInsert", func() { r.ReplaceOrInsert(key1, key1) }, true},
+		{"Remove", func() { r.Remove(key2) }, true},
+		{"Visit", func() { r.Visit(key1, get) }, true},
+		{"Empty", func() { r.Empty() }, false},
+		{"Size", func() { r.Size() }, false},
+		{"Get", func() { r.Get(key1) }, false},
+		{"Find", func() { r.Find(func(finder.Node) finder.Action { return finder.FOUND }) }, false},
+		{"Walk", func() { r.Walk(func(walker.Node) walker.Action { return walker.RETURN }) }, false},
+		{"Min", func() { r.Min() }, false},
+		{"Max", func() { r.Max() }, false},
+		{"Rank", func() { r.Rank(key1) }, false},
+		{"Select", func() { r.Select(0) }, false},
+		{"Validate", func() { r.Validate() }, false},
+	} {
+		reads, writes := l.reads, l.writes
+		test.f()
+		if test.write && (l.writes != writes+1 || l.reads != reads) {
+			t.Fatalf("%s() did not take just the write lock", test.name)
+		}
+		if !test.write && (l.reads != reads+1 || l.writes != writes) {
+			t.Fatalf("%s() did not take just the read lock", test.name)
+		}
+	}
+}
+
+// Test_RLock_Concurrent confirms that readers do not block each other,
+// by running every read-only method while a Walk is in progress
+func Test_RLock_Concurrent(t *testing.T) {
+	r := randomTree(100)
+	r.Walk(func(n walker.Node) walker.Action {
+		done := make(chan bool)
+		go func() {
+			r.Empty()
+			r.Size()
+			r.Get(key1)
+			r.Find(func(finder.Node) finder.Action { return finder.FOUND })
+			r.Walk(func(walker.Node) walker.Action { return walker.RETURN })
+			r.Min()
+			r.Max()
+			r.Rank(key1)
+			r.Select(0)
+			r.Validate()
+			close(done)
+		}()
+		select {
+		case <-done:
+		case <-time.After(10 * time.Second):
+			t.Fatalf("readers blocked while Walk() held the read lock")
+		}
+		return walker.RETURN
+	})
+}
+
+// Test_RLock_Race runs readers and writers concurrently.
+// Run with -race to confirm that the read paths do not modify the tree.
+func Test_RLock_Race(t *testing.T) {
+	const SIZE = 1000
+	const COUNT = 2000
+	const READERS = 8
+	const WRITERS = 2
+	r := randomTree(SIZE)
+	wg := &sync.WaitGroup{}
+	for i := 0; i < READERS; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < COUNT; j++ {
+				k := (i*COUNT + j) % SIZE
+				switch j % 5 {
+				case 0:
+					r.Get(k)
+				case 1:
+					finder.LowerBound(r, k)
+				case 2:
+					walker.ForeachRange(r, k, k+10, true, false, func(_ interface{}, _ interface{}) {})
+				case 3:
+					r.Rank(k)
+				default:
+					r.Min()
+					r.Max()
+					r.Size()
+				}
+			}
+		}(i)
+	}
+	for i := 0; i < WRITERS; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < COUNT; j++ {
+				k := (i*COUNT + j) % SIZE
+				switch j % 3 {
+				case 0:
+					r.Remove(k)
+				case 1:
+					r.ReplaceOrInsert(k, k)
+				default:
+					visitor.GetOrInsert(r, k, k)
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+	assertValidate(r, "", t)
+}
@@ -6,8 +6,8 @@ import . "github.com/iNamik/go_pkg/debug/assert"
 
 // Min
 func (t *tree) Min() (interface{}, interface{}, bool) {
-	t.mutex.Lock()
-	defer t.mutex.Unlock()
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
 	if t.root == nil {
 		return nil, nil, false
 	}
@@ -17,8 +17,8 @@ func (t *tree) Min() (interface{}, interface{}, bool) {
 
 // Max
 func (t *tree) Max() (interface{}, interface{}, bool) {
-	t.mutex.Lock()
-	defer t.mutex.Unlock()
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
 	if t.root == nil {
 		return nil, nil, false
 	}
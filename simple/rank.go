@@ -0,0 +1,64 @@
+package simple
+
+import (
+	"github.com/iNamik/go_cmp"
+)
+
+// tree::Rank
+func (t *tree) Rank(key interface{}) int {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+	return rank(t.root, key, t.fcmp)
+}
+
+// tree::Select
+func (t *tree) Select(i int) (interface{}, interface{}, bool) {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+	if i < 0 || i >= t.size {
+		return nil, nil, false
+	}
+	h := sel(t.root, i)
+	return h.key, h.value, true
+}
+
+// size treats nil as an empty subtree
+func size(h *node) int {
+	if h == nil {
+		return 0
+	}
+	return h.size
+}
+
+// rank returns the number of keys less than key in the subtree rooted at h
+func rank(h *node, key interface{}, fcmp cmp.F) int {
+	r := 0
+	for h != nil {
+		switch fcmp(key, h.key) {
+		case cmp.LT:
+			h = h.left
+		case cmp.GT:
+			r += size(h.left) + 1
+			h = h.right
+		default:
+			return r + size(h.left)
+		}
+	}
+	return r
+}
+
+// sel returns the node with rank i in the subtree rooted at h,
+// assuming 0 <= i < size(h)
+func sel(h *node, i int) *node {
+	for {
+		switch sl := size(h.left); {
+		case i < sl:
+			h = h.left
+		case i > sl:
+			i -= sl + 1
+			h = h.right
+		default:
+			return h
+		}
+	}
+}
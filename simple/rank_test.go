@@ -0,0 +1,89 @@
+package simple
+
+import (
+	"math/rand"
+	"testing"
+)
+
+import (
+	"github.com/iNamik/go_bst/visitor"
+	"github.com/iNamik/go_cmp"
+)
+
+/**********************************************************************
+ ** Assert Functions
+ **********************************************************************/
+
+// assertRank
+func assertRank(r T, key int, rank int, t *testing.T) {
+	if rank_ := r.Rank(key); rank_ != rank {
+		t.Fatalf("Rank(%d) returned %d instead of %d", key, rank_, rank)
+	}
+}
+
+/**********************************************************************
+ ** Test Functions
+ **********************************************************************/
+
+// Test_Rank_Empty
+func Test_Rank_Empty(t *testing.T) {
+	r := New(cmp.F_int)
+	assertRank(r, key1, 0, t)
+	assertKVF(-1, -1, false, func() (interface{}, interface{}, bool) { return r.Select(0) }, t)
+}
+
+// Test_Rank
+func Test_Rank(t *testing.T) {
+	const SIZE = 1000
+	r := randomTreeDouble(SIZE)
+	for k := -1; k <= SIZE*2; k++ {
+		assertRank(r, k, (k+1)/2, t)
+	}
+}
+
+// Test_Select
+func Test_Select(t *testing.T) {
+	const SIZE = 1000
+	r := randomTreeDouble(SIZE)
+	for i := -1; i <= SIZE; i++ {
+		f := func() (interface{}, interface{}, bool) { return r.Select(i) }
+		if i < 0 || i >= SIZE {
+			assertKVF(-1, -1, false, f, t)
+		} else {
+			assertKVF(i*2, i*2, true, f, t)
+		}
+	}
+}
+
+// Test_Rank_Remove confirms that sizes stay correct as nodes are removed
+func Test_Rank_Remove(t *testing.T) {
+	const SIZE = 1000
+	r := randomTree(SIZE * 2)
+	for _, i := range rand.Perm(SIZE) {
+		assertRemove(r, i*2+1, true, t) // Remove the odd keys
+		assertValidate(r, "", t)
+	}
+	for i := 0; i < SIZE; i++ {
+		assertRank(r, i*2, i, t)
+		assertKVF(i*2, i*2, true, func() (interface{}, interface{}, bool) { return r.Select(i) }, t)
+	}
+}
+
+// Test_Rank_Visit confirms that sizes stay correct through Visit
+func Test_Rank_Visit(t *testing.T) {
+	const SIZE = 100
+	r := randomTree(SIZE)
+	for i := 0; i < 10000; i++ {
+		n := rand.Intn(SIZE)
+		r.Visit(n, func(v_ interface{}, found bool) (interface{}, visitor.Action) {
+			if found {
+				return nil, visitor.REMOVE
+			}
+			return n, visitor.INSERT
+		})
+		if i%100 == 0 {
+			assertValidate(r, "", t)
+		}
+	}
+	assertValidate(r, "", t)
+}
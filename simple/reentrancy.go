@@ -0,0 +1,82 @@
+package simple
+
+import (
+	"bytes"
+	"runtime"
+	"strconv"
+	"sync"
+)
+
+// reentrancyDetector is a Locker that panics when a goroutine tries to
+// lock a tree it already holds, instead of deadlocking
+type reentrancyDetector struct {
+	mutex   sync.RWMutex
+	holders sync.Map // Goroutine ids currently holding mutex
+}
+
+// NewReentrancyDetector returns a Locker, for use with NewWithLocker, that
+// panics when a Find, Visit or Walk call-back calls back into the same tree.
+// With the default locker such calls deadlock, either always (when either
+// call needs the write lock) or whenever a writer is waiting (when both
+// calls only need the read lock).
+// Every lock and unlock parses runtime.Stack to find the current goroutine's
+// id, which makes each call more than 100 times slower than with the default
+// locker, so this is intended for debugging and tests, not production.
+func NewReentrancyDetector() Locker {
+	return &reentrancyDetector{}
+}
+
+// reentrancyDetector::NewLocker
+func (d *reentrancyDetector) NewLocker() Locker {
+	return &reentrancyDetector{}
+}
+
+// reentrancyDetector::Lock
+func (d *reentrancyDetector) Lock() {
+	id := d.check()
+	d.mutex.Lock()
+	d.holders.Store(id, true)
+}
+
+// reentrancyDetector::Unlock
+func (d *reentrancyDetector) Unlock() {
+	d.holders.Delete(goid())
+	d.mutex.Unlock()
+}
+
+// reentrancyDetector::RLock
+func (d *reentrancyDetector) RLock() {
+	id := d.check()
+	d.mutex.RLock()
+	d.holders.Store(id, true)
+}
+
+// reentrancyDetector::RUnlock
+func (d *reentrancyDetector) RUnlock() {
+	d.holders.Delete(goid())
+	d.mutex.RUnlock()
+}
+
+// check panics if the current goroutine already holds the lock,
+// otherwise returning the goroutine's id
+func (d *reentrancyDetector) check() int64 {
+	id := goid()
+	if _, held := d.holders.Load(id); held {
+		panic("reentrant call into tree from within a Find, Visit or Walk call-back")
+	}
+	return id
+}
+
+// goid returns the id of the current goroutine, parsed from the
+// first line of its stack trace: "goroutine 123 [running]:"
+func goid() int64 {
+	var buf [64]byte
+	b := buf[:runtime.Stack(buf[:], false)]
+	b = bytes.TrimPrefix(b, []byte("goroutine "))
+	b = b[:bytes.IndexByte(b, ' ')]
+	id, err := strconv.ParseInt(string(b), 10, 64)
+	if err != nil {
+		panic("cannot parse goroutine id: " + err.Error())
+	}
+	return id
+}
@@ -0,0 +1,130 @@
+package simple
+
+import (
+	"strings"
+	"testing"
+)
+
+import (
+	"github.com/iNamik/go_bst/bsttest"
+	"github.com/iNamik/go_bst/finder"
+	"github.com/iNamik/go_bst/visitor"
+	"github.com/iNamik/go_bst/walker"
+	"github.com/iNamik/go_cmp"
+)
+
+/**********************************************************************
+ ** Assert Functions
+ **********************************************************************/
+
+// assertReentrancyPanic confirms that f panics with the reentrancy message
+func assertReentrancyPanic(name string, f func(), t *testing.T) {
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Fatalf("%s did not panic", name)
+		}
+		if s, ok := r.(string); !ok || !strings.Contains(s, "reentrant call") {
+			t.Fatalf("%s panicked with '%v' instead of a reentrant call", name, r)
+		}
+	}()
+	f()
+}
+
+/**********************************************************************
+ ** Helper Functions
+ **********************************************************************/
+
+// detectingTree returns a tree, using a reentrancy detector, with keys 0..n-1
+func detectingTree(n int) T {
+	r := NewWithLocker(cmp.F_int, NewReentrancyDetector())
+	for i := 0; i < n; i++ {
+		r.ReplaceOrInsert(i, i)
+	}
+	return r
+}
+
+// nestedCalls returns a call into r for each of its methods
+func nestedCalls(r T) map[string]func() {
+	return map[string]func(){
+		"Get":             func() { r.Get(key1) },
+		"Find":            func() { r.Find(func(finder.Node) finder.Action { return finder.FOUND }) },
+		"Walk":            func() { r.Walk(func(walker.Node) walker.Action { return walker.RETURN }) },
+		"Min":             func() { r.Min() },
+		"Size":            func() { r.Size() },
+		"ReplaceOrInsert": func() { r.ReplaceOrInsert(key2, key2) },
+		"Remove":          func() { r.Remove(key2) },
+		"Visit":           func() { visitor.Get(r, key1) },
+	}
+}
+
+/**********************************************************************
+ ** Test Functions
+ **********************************************************************/
+
+// Test_Reentrancy_Find
+func Test_Reentrancy_Find(t *testing.T) {
+	r := detectingTree(10)
+	for name, nested := range nestedCalls(r) {
+		assertReentrancyPanic("Find() calling "+name+"()", func() {
+			r.Find(func(finder.Node) finder.Action {
+				nested()
+				return finder.FOUND
+			})
+		}, t)
+	}
+	assertValidate(r, "", t) // The lock was released by each panic
+}
+
+// Test_Reentrancy_Visit
+func Test_Reentrancy_Visit(t *testing.T) {
+	r := detectingTree(10)
+	for name, nested := range nestedCalls(r) {
+		for _, key := range []int{key1, 100} { // Found and not found
+			assertReentrancyPanic("Visit() calling "+name+"()", func() {
+				r.Visit(key, func(_ interface{}, _ bool) (interface{}, visitor.Action) {
+					nested()
+					return nil, visitor.GET
+				})
+			}, t)
+		}
+	}
+	assertValidate(r, "", t)
+}
+
+// Test_Reentrancy_Walk
+func Test_Reentrancy_Walk(t *testing.T) {
+	r := detectingTree(10)
+	for name, nested := range nestedCalls(r) {
+		assertReentrancyPanic("Walk() calling "+name+"()", func() {
+			r.Walk(func(walker.Node) walker.Action {
+				nested()
+				return walker.RETURN
+			})
+		}, t)
+	}
+	assertValidate(r, "", t)
+}
+
+// Test_Reentrancy_OtherGoroutine confirms that a call-back may still use
+// the tree from another goroutine, as long as it does not need the write lock
+func Test_Reentrancy_OtherGoroutine(t *testing.T) {
+	r := detectingTree(10)
+	r.Walk(func(walker.Node) walker.Action {
+		done := make(chan bool)
+		go func() {
+			r.Get(key1)
+			r.Min()
+			close(done)
+		}()
+		<-done
+		return walker.RETURN
+	})
+}
+
+// Test_Reentrancy_BSTTest confirms that the detector does not
+// change the behavior of a tree
+func Test_Reentrancy_BSTTest(t *testing.T) {
+	bsttest.RunVisitor(t, func(fcmp cmp.F) bsttest.Visitor { return NewWithLocker(fcmp, NewReentrancyDetector()) })
+	bsttest.RunWalker(t, func(fcmp cmp.F) bsttest.Walker { return NewWithLocker(fcmp, NewReentrancyDetector()) })
+}
@@ -12,6 +12,7 @@ import (
 
 import (
 	"sync"
+	"sync/atomic"
 )
 
 /**********************************************************************
@@ -25,8 +26,59 @@ type T interface {
 	visitor.I
 	walker.I
 	bst.I_Size
+	bst.I_Rank
+	bst.I_Select
+	bst.I_Validate
 	finder.I_Min
 	finder.I_Max
+	finder.I_FindE
+	visitor.I_VisitE
+	walker.I_WalkE
+	walker.I_WalkContext
+	// Clone returns an independent copy of the tree, with the same shape
+	Clone() T
+	// Snapshot returns a read-only view of the tree as it is now,
+	// unaffected by later changes to the tree
+	Snapshot() Snapshot
+	// Begin starts a transaction (see simple.Txn)
+	Begin() Txn
+	// Cursor returns a new cursor over the tree (see simple.Cursor)
+	Cursor() Cursor
+}
+
+// Snapshot is a read-only view of a tree
+type Snapshot interface {
+	bst.I_Empty
+	bst.I_Size
+	bst.I_Get
+	finder.I
+	walker.I
+	bst.I_Rank
+	bst.I_Select
+	finder.I_Min
+	finder.I_Max
+	// Cursor returns a new cursor over the snapshot
+	Cursor() Cursor
+}
+
+// Locker guards a tree.
+// Read-only methods (Empty, Size, Get, Find, Walk, Min, Max, Rank,
+// Select, Validate and Cursor moves) hold the read lock, while methods
+// that may modify the tree (ReplaceOrInsert, Remove and Visit) hold the
+// write lock.
+// *sync.RWMutex satisfies Locker.
+type Locker interface {
+	sync.Locker
+	RLock()
+	RUnlock()
+}
+
+// I_NewLocker is implemented by a Locker that can create a new, unlocked
+// Locker of its own kind.  Clone uses it to guard the clone the same way
+// as the tree; a clone of a tree whose Locker does not implement it is
+// guarded by a new sync.RWMutex.
+type I_NewLocker interface {
+	NewLocker() Locker
 }
 
 // node
@@ -35,37 +87,54 @@ type node struct {
 	value interface{}
 	left  *node
 	right *node
+	size  int    // Number of nodes in the subtree rooted at this node
+	gen   uint64 // Generation of the tree that may modify this node in place
 }
 
 // tree
 type tree struct {
-	mutex *sync.Mutex
+	mutex Locker
 	root  *node
 	fcmp  cmp.F
 	left  bool // To randomize removal of nodes
 	size  int
+	gen   uint64 // Nodes of any other generation are shared, and copied before being modified
 }
 
+// generation is the last generation handed out by nextGen
+var generation uint64
+
 /**********************************************************************
  ** Public Functions
  **********************************************************************/
 
-// New
+// New creates a tree guarded by a sync.RWMutex, allowing concurrent readers
 func New(fcmp cmp.F) T {
-	return &tree{mutex: &sync.Mutex{}, root: nil, fcmp: fcmp, left: true, size: 0}
+	return NewWithLocker(fcmp, &sync.RWMutex{})
+}
+
+// NewWithLocker creates a tree guarded by mutex
+func NewWithLocker(fcmp cmp.F, mutex Locker) T {
+	return &tree{mutex: mutex, root: nil, fcmp: fcmp, left: true, size: 0, gen: nextGen()}
+}
+
+// NewUnsynchronized creates a tree that does no locking at all.
+// It must only be used by one goroutine at a time.
+func NewUnsynchronized(fcmp cmp.F) T {
+	return NewWithLocker(fcmp, noLocker{})
 }
 
 // tree:Empty
 func (t *tree) Empty() bool {
-	t.mutex.Lock()
-	defer t.mutex.Unlock()
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
 	return t.size == 0
 }
 
 // tree:Size
 func (t *tree) Size() int {
-	t.mutex.Lock()
-	defer t.mutex.Unlock()
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
 	return t.size
 }
 
@@ -74,7 +143,7 @@ func (t *tree) ReplaceOrInsert(key interface{}, value interface{}) bool {
 	t.mutex.Lock()
 	defer t.mutex.Unlock()
 	var replaced bool
-	t.root, replaced = replaceOrInsert(t.root, key, value, t.fcmp)
+	t.root, replaced = replaceOrInsert(t.root, key, value, t.fcmp, t.gen)
 	if !replaced {
 		t.size++
 	}
@@ -83,8 +152,8 @@ func (t *tree) ReplaceOrInsert(key interface{}, value interface{}) bool {
 
 // tree::Get
 func (t *tree) Get(key interface{}) (interface{}, bool) {
-	t.mutex.Lock()
-	defer t.mutex.Unlock()
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
 	h := get(t.root, key, t.fcmp)
 	if h != nil {
 		return h.value, true
@@ -97,7 +166,7 @@ func (t *tree) Remove(key interface{}) bool {
 	t.mutex.Lock()
 	defer t.mutex.Unlock()
 	var removed bool
-	t.root, removed, t.left = remove(t.root, key, t.fcmp, t.left)
+	t.root, removed, t.left = remove(t.root, key, t.fcmp, t.left, t.gen)
 	if removed {
 		t.size--
 	}
@@ -108,20 +177,60 @@ func (t *tree) Remove(key interface{}) bool {
  ** Private Functions
  **********************************************************************/
 
+// nextGen returns a generation that no tree has used before.
+// Generation 0 is never returned, and is used by snapshots, which never modify nodes.
+func nextGen() uint64 {
+	return atomic.AddUint64(&generation, 1)
+}
+
+// own returns h if generation gen may modify it in place,
+// otherwise a copy of h that generation gen may modify
+func own(h *node, gen uint64) *node {
+	if h.gen == gen {
+		return h
+	}
+	n := *h
+	n.gen = gen
+	return &n
+}
+
+// noLocker is a Locker that does nothing
+type noLocker struct{}
+
+// noLocker::NewLocker
+func (noLocker) NewLocker() Locker { return noLocker{} }
+
+// noLocker::Lock
+func (noLocker) Lock() {}
+
+// noLocker::Unlock
+func (noLocker) Unlock() {}
+
+// noLocker::RLock
+func (noLocker) RLock() {}
+
+// noLocker::RUnlock
+func (noLocker) RUnlock() {}
+
 // replaceOrInsert returns true if key was replaced, false if it was inserted into the tree
-func replaceOrInsert(h *node, key interface{}, value interface{}, fcmp cmp.F) (*node, bool) {
+func replaceOrInsert(h *node, key interface{}, value interface{}, fcmp cmp.F, gen uint64) (*node, bool) {
 	if h == nil {
-		return &node{key: key, value: value}, false
+		return &node{key: key, value: value, size: 1, gen: gen}, false
 	}
+	// Either way, every node on the path to key changes
+	h = own(h, gen)
 	replaced := true
 	switch fcmp(key, h.key) {
 	case cmp.LT:
-		h.left, replaced = replaceOrInsert(h.left, key, value, fcmp)
+		h.left, replaced = replaceOrInsert(h.left, key, value, fcmp, gen)
 	case cmp.GT:
-		h.right, replaced = replaceOrInsert(h.right, key, value, fcmp)
+		h.right, replaced = replaceOrInsert(h.right, key, value, fcmp, gen)
 	default:
 		h.value = value
 	}
+	if !replaced {
+		h.size++
+	}
 	return h, replaced
 }
 
@@ -140,26 +249,40 @@ func get(h *node, key interface{}, fcmp cmp.F) *node {
 	return nil
 }
 
-// remove
-func remove(h *node, key interface{}, fcmp cmp.F, left bool) (*node, bool, bool) {
+// remove only changes (and so copies) the nodes on the path to key if key is found
+func remove(h *node, key interface{}, fcmp cmp.F, left bool, gen uint64) (*node, bool, bool) {
 	removed := false
 	newLeft := left
 	if h != nil {
+		var child *node
 		switch fcmp(key, h.key) {
 		case cmp.LT:
-			h.left, removed, newLeft = remove(h.left, key, fcmp, left)
+			child, removed, newLeft = remove(h.left, key, fcmp, left, gen)
+			if removed {
+				h = own(h, gen)
+				h.left = child
+			}
 		case cmp.GT:
-			h.right, removed, newLeft = remove(h.right, key, fcmp, left)
+			child, removed, newLeft = remove(h.right, key, fcmp, left, gen)
+			if removed {
+				h = own(h, gen)
+				h.right = child
+			}
 		default:
-			h, newLeft = removeNode(h, left)
-			removed = true
+			h, newLeft = removeNode(h, left, gen)
+			return h, true, newLeft
+		}
+		if removed {
+			h.size--
 		}
 	}
 	return h, removed, newLeft
 }
 
-// removeNode
-func removeNode(h *node, left bool) (*node, bool) {
+// removeNode returns the node that replaces h, keeping the size of
+// every node whose subtree changes correct.  h itself is not modified,
+// and every other node that changes is owned by generation gen.
+func removeNode(h *node, left bool, gen uint64) (*node, bool) {
 	// If there are any children
 	if h.left != nil || h.right != nil {
 		var n *node = nil // Replacement node
@@ -170,16 +293,21 @@ func removeNode(h *node, left bool) (*node, bool) {
 			left = !(h.right != nil)
 			// If there is no left.right node
 			if h.left.right == nil {
-				n = h.left
+				n = own(h.left, gen)
 			} else {
-				// Find parent of max(h.left)
-				var nParent *node = h.left
+				// Find parent of max(h.left), each node along
+				// the way loses max(h.left) from its subtree
+				var nLeft *node = own(h.left, gen)
+				var nParent *node = nLeft
+				nParent.size--
 				for nParent.right.right != nil {
+					nParent.right = own(nParent.right, gen)
 					nParent = nParent.right
+					nParent.size--
 				}
-				n = nParent.right
+				n = own(nParent.right, gen)
 				nParent.right = n.left
-				n.left = h.left
+				n.left = nLeft
 			}
 			n.right = h.right
 
@@ -189,19 +317,25 @@ func removeNode(h *node, left bool) (*node, bool) {
 			left = (h.left != nil)
 			// If there is no right.left node
 			if h.right.left == nil {
-				n = h.right
+				n = own(h.right, gen)
 			} else {
-				// Find parent of min(h.left)
-				var nParent *node = h.right
+				// Find parent of min(h.right), each node along
+				// the way loses min(h.right) from its subtree
+				var nRight *node = own(h.right, gen)
+				var nParent *node = nRight
+				nParent.size--
 				for nParent.left.left != nil {
+					nParent.left = own(nParent.left, gen)
 					nParent = nParent.left
+					nParent.size--
 				}
-				n = nParent.left
+				n = own(nParent.left, gen)
 				nParent.left = n.right
-				n.right = h.right
+				n.right = nRight
 			}
 			n.left = h.left
 		}
+		n.size = h.size - 1
 		return n, left
 	}
 	return nil, left
@@ -0,0 +1,131 @@
+package simple
+
+import (
+	"github.com/iNamik/go_bst"
+	"github.com/iNamik/go_bst/finder"
+	"github.com/iNamik/go_bst/visitor"
+	"github.com/iNamik/go_bst/walker"
+)
+
+// Txn is a transaction on a tree.
+// Changes made through a Txn become visible together, when it is
+// committed, or not at all, when it is rolled back.
+// A Txn holds the tree's write lock until it is committed or rolled back,
+// so the tree must not be used directly, even by the goroutine that
+// began the transaction, until then.
+// Rollback does nothing once the transaction has ended, so
+// `defer x.Rollback()` releases the lock even if a panic prevents Commit.
+type Txn interface {
+	bst.T
+	finder.I
+	visitor.I
+	walker.I
+	bst.I_Size
+	finder.I_Min
+	finder.I_Max
+	// Commit makes the changes visible and ends the transaction
+	Commit()
+	// Rollback discards the changes and ends the transaction,
+	// or does nothing if it has already ended
+	Rollback()
+}
+
+// txn works on a private, unlocked tree that shares every node
+// with the tree it was begun on, until it is committed
+type txn struct {
+	t    *tree
+	tx   *tree
+	done bool
+}
+
+// tree::Begin takes the write lock, which the transaction holds until it ends
+func (t *tree) Begin() Txn {
+	t.mutex.Lock()
+	// The transaction has its own generation, so it copies any node of t before modifying it
+	tx := &tree{mutex: noLocker{}, root: t.root, fcmp: t.fcmp, left: t.left, size: t.size, gen: nextGen()}
+	return &txn{t: t, tx: tx, done: false}
+}
+
+// txn::Commit
+func (x *txn) Commit() {
+	x.check()
+	x.done = true
+	x.t.root, x.t.left, x.t.size, x.t.gen = x.tx.root, x.tx.left, x.tx.size, x.tx.gen
+	x.t.mutex.Unlock()
+}
+
+// txn::Rollback
+func (x *txn) Rollback() {
+	if x.done {
+		return
+	}
+	x.done = true
+	x.t.mutex.Unlock()
+}
+
+// txn::Empty
+func (x *txn) Empty() bool {
+	x.check()
+	return x.tx.Empty()
+}
+
+// txn::Size
+func (x *txn) Size() int {
+	x.check()
+	return x.tx.Size()
+}
+
+// txn::ReplaceOrInsert
+func (x *txn) ReplaceOrInsert(key interface{}, value interface{}) bool {
+	x.check()
+	return x.tx.ReplaceOrInsert(key, value)
+}
+
+// txn::Get
+func (x *txn) Get(key interface{}) (interface{}, bool) {
+	x.check()
+	return x.tx.Get(key)
+}
+
+// txn::Remove
+func (x *txn) Remove(key interface{}) bool {
+	x.check()
+	return x.tx.Remove(key)
+}
+
+// txn::Find
+func (x *txn) Find(f finder.F) (interface{}, interface{}, bool) {
+	x.check()
+	return x.tx.Find(f)
+}
+
+// txn::Visit
+func (x *txn) Visit(key interface{}, f visitor.F) (interface{}, visitor.Result) {
+	x.check()
+	return x.tx.Visit(key, f)
+}
+
+// txn::Walk
+func (x *txn) Walk(f walker.F) {
+	x.check()
+	x.tx.Walk(f)
+}
+
+// txn::Min
+func (x *txn) Min() (interface{}, interface{}, bool) {
+	x.check()
+	return x.tx.Min()
+}
+
+// txn::Max
+func (x *txn) Max() (interface{}, interface{}, bool) {
+	x.check()
+	return x.tx.Max()
+}
+
+// txn::check panics if the transaction has ended
+func (x *txn) check() {
+	if x.done {
+		panic("transaction has already been committed or rolled back")
+	}
+}
@@ -0,0 +1,199 @@
+package simple
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+import (
+	"github.com/iNamik/go_bst"
+	"github.com/iNamik/go_bst/bsttest"
+	"github.com/iNamik/go_bst/visitor"
+	"github.com/iNamik/go_bst/walker"
+	"github.com/iNamik/go_cmp"
+)
+
+/**********************************************************************
+ ** Helper Functions
+ **********************************************************************/
+
+// transfer moves amount from key a to key b in one transaction,
+// rolling back if a does not hold enough
+func transfer(r T, a int, b int, amount int) bool {
+	x := r.Begin()
+	va, _ := x.Get(a)
+	if va.(int) < amount {
+		x.Rollback()
+		return false
+	}
+	x.ReplaceOrInsert(a, va.(int)-amount)
+	x.Visit(b, func(value interface{}, found bool) (interface{}, visitor.Action) {
+		return value.(int) + amount, visitor.REPLACE
+	})
+	x.Commit()
+	return true
+}
+
+/**********************************************************************
+ ** Test Functions
+ **********************************************************************/
+
+// Test_Txn_Commit
+func Test_Txn_Commit(t *testing.T) {
+	r := randomTree(100)
+	x := r.Begin()
+	x.Remove(key1)
+	x.ReplaceOrInsert(1000, 1000)
+	visitor.GetAndReplace(x, key2, 200)
+	if x.Size() != 100 {
+		t.Fatalf("Size() returned %d inside the transaction instead of %d", x.Size(), 100)
+	}
+	// Other goroutines cannot see the changes until they are committed
+	done := make(chan bool)
+	go func() {
+		r.Get(key1)
+		close(done)
+	}()
+	select {
+	case <-done:
+		t.Fatalf("Get() did not wait for the transaction to end")
+	case <-time.After(10 * time.Millisecond):
+	}
+	x.Commit()
+	<-done
+	assertGet(r, key1, nil, false, t)
+	assertGet(r, 1000, 1000, true, t)
+	assertGet(r, key2, 200, true, t)
+	assertSize(r, 100, t)
+	assertValidate(r, "", t)
+}
+
+// Test_Txn_Rollback confirms that a rolled back transaction leaves
+// the tree exactly as it was, including its shape
+func Test_Txn_Rollback(t *testing.T) {
+	r := randomTree(100)
+	c := r.Clone()
+	x := r.Begin()
+	for k := 0; k < 100; k += 2 {
+		x.Remove(k)
+		x.ReplaceOrInsert(k+1, -1)
+	}
+	walker.ForeachMin(x, func(k interface{}, v interface{}) {
+		if v != -1 {
+			t.Fatalf("transaction has (%v, %v) instead of (%v, %v)", k, v, k, -1)
+		}
+	})
+	x.Rollback()
+	assertSameShape(r.(*tree).root, c.(*tree).root, t)
+	assertSize(r, 100, t)
+	assertValidate(r, "", t)
+}
+
+// Test_Txn_Snapshot confirms that a committed transaction does not change an earlier snapshot
+func Test_Txn_Snapshot(t *testing.T) {
+	r := New(cmp.F_int)
+	for k := 0; k < 100; k++ {
+		r.ReplaceOrInsert(k, k)
+	}
+	s := r.Snapshot()
+	x := r.Begin()
+	for k := 0; k < 100; k++ {
+		x.Remove(k)
+	}
+	x.Commit()
+	assertEmpty(r, true, t)
+	assertKeys(s, 0, 100, t)
+}
+
+// Test_Txn_Ended
+func Test_Txn_Ended(t *testing.T) {
+	const MSG = "transaction has already been committed or rolled back"
+	r := randomTree(10)
+	x := r.Begin()
+	x.Commit()
+	assertPanic(t, MSG, func() { x.Get(key1) })
+	assertPanic(t, MSG, func() { x.ReplaceOrInsert(key1, key1) })
+	assertPanic(t, MSG, func() { x.Commit() })
+	x.Rollback() // Does nothing
+	x = r.Begin()
+	x.Rollback()
+	assertPanic(t, MSG, func() { x.Remove(key1) })
+	assertPanic(t, MSG, func() { x.Commit() })
+	x.Rollback() // Does nothing
+	assertSize(r, 10, t) // And the lock was released
+}
+
+// Test_Txn_DeferRollback confirms that a deferred Rollback releases the
+// lock when a transaction panics, and does nothing after Commit
+func Test_Txn_DeferRollback(t *testing.T) {
+	r := randomTree(10)
+	func() {
+		defer func() { recover() }()
+		x := r.Begin()
+		defer x.Rollback()
+		x.Remove(key1)
+		panic("oops")
+	}()
+	// The lock was released, and the change discarded
+	r.ReplaceOrInsert(1000, 1000)
+	assertGet(r, key1, key1, true, t)
+	assertSize(r, 11, t)
+	func() {
+		x := r.Begin()
+		defer x.Rollback()
+		x.Remove(key1)
+		x.Commit()
+	}()
+	r.ReplaceOrInsert(1001, 1001)
+	assertGet(r, key1, nil, false, t)
+	assertSize(r, 11, t)
+}
+
+// Test_Txn_Concurrent transfers amounts between keys from several goroutines,
+// while readers confirm that the total never changes.
+// Run with -race to confirm that transactions do not share nodes with readers.
+func Test_Txn_Concurrent(t *testing.T) {
+	const SIZE = 100
+	const COUNT = 1000
+	const TOTAL = SIZE * 10
+	r := New(cmp.F_int)
+	for k := 0; k < SIZE; k++ {
+		r.ReplaceOrInsert(k, TOTAL/SIZE)
+	}
+	wg := &sync.WaitGroup{}
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < COUNT; j++ {
+				transfer(r, (i*j)%SIZE, (i+j)%SIZE, j%7)
+			}
+		}(i)
+	}
+	totals := make(chan int, COUNT)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer close(totals)
+		for j := 0; j < COUNT; j++ {
+			total := 0
+			walker.ForeachMin(r, func(_ interface{}, v interface{}) { total += v.(int) })
+			totals <- total
+		}
+	}()
+	for total := range totals {
+		if total != TOTAL {
+			t.Fatalf("keys totalled %d instead of %d", total, TOTAL)
+		}
+	}
+	wg.Wait()
+	assertValidate(r, "", t)
+}
+
+// Test_BSTTest_Txn runs the conformance suite inside a transaction
+func Test_BSTTest_Txn(t *testing.T) {
+	bsttest.RunT(t, func(fcmp cmp.F) bst.T { return New(fcmp).Begin() })
+	bsttest.RunVisitor(t, func(fcmp cmp.F) bsttest.Visitor { return New(fcmp).Begin() })
+	bsttest.RunWalker(t, func(fcmp cmp.F) bsttest.Walker { return New(fcmp).Begin() })
+}
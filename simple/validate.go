@@ -0,0 +1,59 @@
+package simple
+
+import (
+	"fmt"
+	"strings"
+)
+
+import (
+	"github.com/iNamik/go_cmp"
+)
+
+// tree::Validate confirms that keys are strictly ordered, that the
+// tree contains no cycles, and that the cached sizes of the tree and
+// of each node's subtree match the number of nodes.  Errors identify
+// the offending node by its path from the root (e.g. "root.left.right").
+func (t *tree) Validate() error {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+	count, err := validate(t.root, []string{"root"}, nil, nil, t.fcmp, map[*node]bool{})
+	if err != nil {
+		return err
+	}
+	if count != t.size {
+		return fmt.Errorf("tree has size %d but contains %d nodes", t.size, count)
+	}
+	return nil
+}
+
+// validate confirms that every key in the subtree rooted at h is strictly
+// between the keys of lo and hi (nil meaning unbounded), that no node
+// is reachable twice, and that each node's size is correct, returning
+// the number of nodes in the subtree
+func validate(h *node, path []string, lo *node, hi *node, fcmp cmp.F, seen map[*node]bool) (int, error) {
+	if h == nil {
+		return 0, nil
+	}
+	if seen[h] {
+		return 0, fmt.Errorf("node %s (key '%v') was already visited, tree contains a cycle", strings.Join(path, "."), h.key)
+	}
+	seen[h] = true
+	if lo != nil && fcmp(h.key, lo.key) != cmp.GT {
+		return 0, fmt.Errorf("node %s (key '%v') is not greater than ancestor key '%v'", strings.Join(path, "."), h.key, lo.key)
+	}
+	if hi != nil && fcmp(h.key, hi.key) != cmp.LT {
+		return 0, fmt.Errorf("node %s (key '%v') is not less than ancestor key '%v'", strings.Join(path, "."), h.key, hi.key)
+	}
+	nl, err := validate(h.left, append(path, "left"), lo, h, fcmp, seen)
+	if err != nil {
+		return 0, err
+	}
+	nr, err := validate(h.right, append(path, "right"), h, hi, fcmp, seen)
+	if err != nil {
+		return 0, err
+	}
+	if h.size != nl+nr+1 {
+		return 0, fmt.Errorf("node %s (key '%v') has size %d but its subtree contains %d nodes", strings.Join(path, "."), h.key, h.size, nl+nr+1)
+	}
+	return nl + nr + 1, nil
+}
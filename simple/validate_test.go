@@ -0,0 +1,84 @@
+package simple
+
+import (
+	"strings"
+	"testing"
+)
+
+import (
+	"github.com/iNamik/go_cmp"
+)
+
+/**********************************************************************
+ ** Assert Functions
+ **********************************************************************/
+
+// assertValidate confirms that Validate() returns nil when msg is "",
+// and otherwise returns an error containing msg
+func assertValidate(r T, msg string, t *testing.T) {
+	err := r.Validate()
+	if msg == "" {
+		if err != nil {
+			t.Fatalf("Validate() returned '%v' instead of nil", err)
+		}
+	} else if err == nil {
+		t.Fatalf("Validate() returned nil instead of '%s'", msg)
+	} else if !strings.Contains(err.Error(), msg) {
+		t.Fatalf("Validate() returned '%v', which does not contain '%s'", err, msg)
+	}
+}
+
+/**********************************************************************
+ ** Test Functions
+ **********************************************************************/
+
+// Test_Validate_Empty
+func Test_Validate_Empty(t *testing.T) {
+	assertValidate(New(cmp.F_int), "", t)
+}
+
+// Test_Validate_Random
+func Test_Validate_Random(t *testing.T) {
+	r := randomTree(1000)
+	assertValidate(r, "", t)
+	for i := 0; i < 1000; i += 2 {
+		r.Remove(i)
+	}
+	assertValidate(r, "", t)
+}
+
+// Test_Validate_Order
+func Test_Validate_Order(t *testing.T) {
+	r := New(cmp.F_int)
+	r.ReplaceOrInsert(key5, key5)
+	r.ReplaceOrInsert(key2, key2)
+	r.ReplaceOrInsert(key4, key4)
+	// Corrupt root.left.right, which must be between 2 and 5
+	r.(*tree).root.left.right.key = key6
+	assertValidate(r, "node root.left.right (key '6') is not less than ancestor key '5'", t)
+}
+
+// Test_Validate_Duplicate
+func Test_Validate_Duplicate(t *testing.T) {
+	r := New(cmp.F_int)
+	r.ReplaceOrInsert(key5, key5)
+	r.ReplaceOrInsert(key7, key7)
+	r.(*tree).root.right.key = key5
+	assertValidate(r, "node root.right (key '5') is not greater than ancestor key '5'", t)
+}
+
+// Test_Validate_Size
+func Test_Validate_Size(t *testing.T) {
+	r := randomTree(10)
+	r.(*tree).size++
+	assertValidate(r, "tree has size 11 but contains 10 nodes", t)
+}
+
+// Test_Validate_Cycle
+func Test_Validate_Cycle(t *testing.T) {
+	r := New(cmp.F_int)
+	r.ReplaceOrInsert(key5, key5)
+	r.ReplaceOrInsert(key2, key2)
+	r.(*tree).root.left.left = r.(*tree).root
+	assertValidate(r, "node root.left.left (key '5') was already visited", t)
+}
@@ -11,7 +11,7 @@ import (
 func (t *tree) Visit(key interface{}, f visitor.F) (value interface{}, result visitor.Result) {
 	t.mutex.Lock()
 	defer t.mutex.Unlock()
-	t.root, value, result, t.left = visit(t.root, key, t.fcmp, f, t.left)
+	t.root, value, result, t.left = visit(t.root, key, t.fcmp, f, t.left, t.gen)
 	if result == visitor.INSERTED {
 		t.size++
 	} else if result == visitor.REMOVED {
@@ -20,25 +20,40 @@ func (t *tree) Visit(key interface{}, f visitor.F) (value interface{}, result vi
 	return value, result
 }
 
-// visit
-func visit(h *node, key interface{}, fcmp cmp.F, f visitor.F, left bool) (_ *node, value interface{}, result visitor.Result, _ bool) {
+// tree::VisitE (see visitor.VisitE)
+func (t *tree) VisitE(key interface{}, f visitor.F) (value interface{}, result visitor.Result, err error) {
+	return visitor.VisitE(t, key, f)
+}
+
+// visit only changes (and so copies) the nodes on the path to key if
+// the visitor inserts, replaces or removes key
+func visit(h *node, key interface{}, fcmp cmp.F, f visitor.F, left bool, gen uint64) (_ *node, value interface{}, result visitor.Result, _ bool) {
 	if h == nil {
 		var action visitor.Action
 		value, action = f(nil, false)
 		switch action {
 		case visitor.INSERT:
-			return &node{key: key, value: value}, value, visitor.INSERTED, left
+			return &node{key: key, value: value, size: 1, gen: gen}, value, visitor.INSERTED, left
 		case visitor.GET:
 			return nil, nil, visitor.NOT_FOUND, left
 		default:
 			panic(fmt.Sprintf("illegal action '%s' when visiting non-found key", action))
 		}
 	}
+	var child *node
 	switch fcmp(key, h.key) {
 	case cmp.LT:
-		h.left, value, result, left = visit(h.left, key, fcmp, f, left)
+		child, value, result, left = visit(h.left, key, fcmp, f, left, gen)
+		if changed(result) {
+			h = own(h, gen)
+			h.left = child
+		}
 	case cmp.GT:
-		h.right, value, result, left = visit(h.right, key, fcmp, f, left)
+		child, value, result, left = visit(h.right, key, fcmp, f, left, gen)
+		if changed(result) {
+			h = own(h, gen)
+			h.right = child
+		}
 	default:
 		var action visitor.Action
 		value, action = f(h.value, true)
@@ -47,15 +62,27 @@ func visit(h *node, key interface{}, fcmp cmp.F, f visitor.F, left bool) (_ *nod
 			value = h.value
 			result = visitor.FOUND
 		case visitor.REPLACE:
+			h = own(h, gen)
 			h.value = value
 			result = visitor.REPLACED
 		case visitor.REMOVE:
 			value = h.value
-			h, left = removeNode(h, left)
+			h, left = removeNode(h, left, gen)
 			result = visitor.REMOVED
 		default:
 			panic(fmt.Sprintf("illegal action '%s' when visiting found key", action))
 		}
+		return h, value, result, left
+	}
+	if result == visitor.INSERTED {
+		h.size++
+	} else if result == visitor.REMOVED {
+		h.size--
 	}
 	return h, value, result, left
 }
+
+// changed returns true if result means the tree was changed
+func changed(result visitor.Result) bool {
+	return result == visitor.INSERTED || result == visitor.REPLACED || result == visitor.REMOVED
+}
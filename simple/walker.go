@@ -1,6 +1,9 @@
 package simple
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 import (
 	"github.com/iNamik/go_bst/walker"
@@ -78,8 +81,8 @@ func (w *wnode) HasParent() bool {
 
 // tree::Walk
 func (t *tree) Walk(f walker.F) {
-	t.mutex.Lock()
-	defer t.mutex.Unlock()
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
 	// We don't walk an empty tree
 	if t.root == nil {
 		return
@@ -87,6 +90,16 @@ func (t *tree) Walk(f walker.F) {
 	walk(t.root, nil, nil, w_node, 1, t.fcmp, f)
 }
 
+// tree::WalkE (see walker.WalkE)
+func (t *tree) WalkE(f walker.F) error {
+	return walker.WalkE(t, f)
+}
+
+// tree::WalkContext (see walker.WalkContext)
+func (t *tree) WalkContext(ctx context.Context, f walker.F) error {
+	return walker.WalkContext(ctx, t, f)
+}
+
 // walk uses recursion to support walking up and down the tree.
 // If our tree node contained a reference to parent, this would
 // probably be much easier.
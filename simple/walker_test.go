@@ -337,6 +337,138 @@ func Test_Walk_Random(t *testing.T) {
 	})
 }
 
+// Test_Walk_ForeachRange
+func Test_Walk_ForeachRange(t *testing.T) {
+	r := randomTreeDouble(1000)
+	i := 500
+	walker.ForeachRange(r, 499, 510, false, true, func(k interface{}, v interface{}) {
+		if k.(int) != i {
+			t.Fatalf("encountered '%d' instead of '%d", k, i)
+		}
+		i += 2
+	})
+	if i != 512 {
+		t.Fatalf("range ended at '%d' instead of '%d'", i-2, 510)
+	}
+}
+
+// Test_Walk_ForeachRangeReverse
+func Test_Walk_ForeachRangeReverse(t *testing.T) {
+	r := randomTreeDouble(1000)
+	i := 508
+	walker.ForeachRangeReverse(r, 500, 510, true, false, func(k interface{}, v interface{}) {
+		if k.(int) != i {
+			t.Fatalf("encountered '%d' instead of '%d", k, i)
+		}
+		i -= 2
+	})
+	if i != 498 {
+		t.Fatalf("range ended at '%d' instead of '%d'", i+2, 500)
+	}
+}
+
+// Test_Walk_ForeachRange_Seek confirms that ForeachRange does not visit
+// the keys preceding the range
+func Test_Walk_ForeachRange_Seek(t *testing.T) {
+	const SIZE = 10000
+	r := randomTree(SIZE)
+	count := 0
+	w := walkerFunc(func(f walker.F) {
+		r.Walk(func(n walker.Node) walker.Action {
+			count++
+			return f(n)
+		})
+	})
+	walker.ForeachRange(w, SIZE-10, SIZE, true, false, func(k interface{}, v interface{}) {})
+	// 10 keys in range, plus the search path down to the first of them
+	if count > 10+height(r.(*tree).root) {
+		t.Fatalf("visited %d nodes for a range of 10 keys", count)
+	}
+}
+
+// Test_Walk_ForeachMinWhile
+func Test_Walk_ForeachMinWhile(t *testing.T) {
+	r := randomTree(1000)
+	i := 0
+	walker.ForeachMinWhile(r, func(k interface{}, v interface{}) bool {
+		if k.(int) != i {
+			t.Fatalf("encountered '%d' instead of '%d", k, i)
+		}
+		i++
+		return i < 10
+	})
+	if i != 10 {
+		t.Fatalf("iteration stopped after '%d' keys instead of '%d'", i, 10)
+	}
+}
+
+// Test_Walk_ForeachMaxWhile
+func Test_Walk_ForeachMaxWhile(t *testing.T) {
+	r := randomTree(1000)
+	i := 999
+	walker.ForeachMaxWhile(r, func(k interface{}, v interface{}) bool {
+		if k.(int) != i {
+			t.Fatalf("encountered '%d' instead of '%d", k, i)
+		}
+		i--
+		return i > 989
+	})
+	if i != 989 {
+		t.Fatalf("iteration stopped at '%d' instead of '%d'", i+1, 990)
+	}
+}
+
+// Test_Walk_ForeachRangeWhile
+func Test_Walk_ForeachRangeWhile(t *testing.T) {
+	r := randomTreeDouble(1000)
+	i := 500
+	walker.ForeachRangeWhile(r, 500, 600, true, true, func(k interface{}, v interface{}) bool {
+		if k.(int) != i {
+			t.Fatalf("encountered '%d' instead of '%d", k, i)
+		}
+		i += 2
+		return k.(int) < 510
+	})
+	if i != 512 {
+		t.Fatalf("iteration stopped at '%d' instead of '%d'", i-2, 510)
+	}
+}
+
+// Test_Walk_ForeachRangeReverseWhile
+func Test_Walk_ForeachRangeReverseWhile(t *testing.T) {
+	r := randomTreeDouble(1000)
+	i := 600
+	walker.ForeachRangeReverseWhile(r, 500, 600, true, true, func(k interface{}, v interface{}) bool {
+		if k.(int) != i {
+			t.Fatalf("encountered '%d' instead of '%d", k, i)
+		}
+		i -= 2
+		return k.(int) > 590
+	})
+	if i != 588 {
+		t.Fatalf("iteration stopped at '%d' instead of '%d'", i+2, 590)
+	}
+}
+
+// Test_Walk_ForeachMinWhile_Stop confirms that ForeachMinWhile does not
+// visit nodes after f returns false
+func Test_Walk_ForeachMinWhile_Stop(t *testing.T) {
+	const SIZE = 10000
+	r := randomTree(SIZE)
+	count := 0
+	w := walkerFunc(func(f walker.F) {
+		r.Walk(func(n walker.Node) walker.Action {
+			count++
+			return f(n)
+		})
+	})
+	walker.ForeachMinWhile(w, func(k interface{}, v interface{}) bool { return k.(int) < 9 })
+	// 10 keys visited, plus the search path down to the first of them
+	if count > 10+height(r.(*tree).root) {
+		t.Fatalf("visited %d nodes for 10 keys", count)
+	}
+}
+
 // Test_Walk_Exception_Left
 func Test_Walk_Exception_Left(t *testing.T) {
 	r := New(cmp.F_int)
@@ -402,3 +534,25 @@ func Test_Walk_Exception_Illegal(t *testing.T) {
 		})
 	})
 }
+
+/**********************************************************************
+ ** Helper Functions
+ **********************************************************************/
+
+// walkerFunc allows us to attach walker.I onto a closure
+type walkerFunc func(walker.F)
+
+// walkerFunc::Walk
+func (w walkerFunc) Walk(f walker.F) { w(f) }
+
+// height
+func height(h *node) int {
+	if h == nil {
+		return 0
+	}
+	hl, hr := height(h.left), height(h.right)
+	if hl > hr {
+		return hl + 1
+	}
+	return hr + 1
+}
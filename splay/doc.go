@@ -0,0 +1,100 @@
+/*
+
+Package splay provides a self-adjusting implementation of an
+extensible Binary Search Tree as defined in the go_bst
+package and sub-packages.
+
+Every access moves the accessed node to the root of the tree,
+so recently accessed keys are cheap to access again.  This suits
+workloads where lookups are heavily skewed toward a small set of
+keys.  Operations run in O(log n) amortized time, although any
+single operation may take O(n).
+
+
+Standard BST Methods
+--------------------
+
+All of the standard BST methods required to satisfy the
+bst.T interface have been implemented:
+
+ * Empty
+ * ReplaceOrInsert
+ * Get
+ * Remove
+
+
+Extensible BST Methods
+----------------------
+
+All of the extensible interfaces have been implemented:
+
+ * Find  (see finder.T)
+ * Visit (see visitor.T)
+ * Walk  (see walker.T)
+
+
+Additional BST Methods
+----------------------
+
+The following additional BST methods have been implemented:
+
+ * Size (see bst.I_Size)
+ * Min  (see finder.I_Min)
+ * Max  (see finder.I_Max)
+
+
+Splaying
+--------
+
+The following methods splay the accessed node to the root:
+
+ * ReplaceOrInsert (the replaced or inserted node)
+ * Get             (the found node)
+ * Remove          (the removed node's neighbor)
+ * Visit           (the visited node, before calling the visitor)
+ * Min             (the minimum node)
+ * Max             (the maximum node)
+
+When a key is not found, the last node visited while searching
+for it is splayed instead.
+
+Find splays the node at which the search ended, whether the
+finder returned FOUND or NOT_FOUND, or ran out of nodes.  This
+means that the finder-based helpers (finder.Get, finder.LowerBound,
+etc.) also adapt the tree to the keys being accessed.
+
+Walk does not splay.  Moving nodes while they are being walked
+would invalidate the walker's position, so the shape of the tree
+is left unchanged.  Walking is therefore no more efficient for
+recently accessed keys, and the walker-based helpers
+(walker.Get, walker.ForeachMin, etc.) do not adapt the tree.
+
+Since every access may change the shape of the tree, all methods,
+including Get and Find, take the tree's exclusive lock.
+
+Size, Min and Max return the same results as the simple package.
+
+
+Effeciency
+----------
+
+Splaying is performed top-down, so it needs neither recursion nor
+parent pointers.  Walk, however, uses recursion, and the tree may
+temporarily be O(n) deep, for example after inserting keys in
+sorted order.
+
+
+License
+-------
+
+This package is released under the MIT License.
+See included file 'LICENSE' for more details.
+
+
+Contributors
+------------
+
+David Farell <DavidPFarrell@yahoo.com>
+
+*/
+package splay
@@ -0,0 +1,72 @@
+package splay
+
+import "fmt"
+
+import (
+	"github.com/iNamik/go_bst/finder"
+	"github.com/iNamik/go_cmp"
+)
+
+// rnode
+type rnode struct {
+	n    *node
+	fcmp cmp.F
+}
+
+// rnode::Key
+func (r *rnode) Key() interface{} {
+	return r.n.key
+}
+
+// rnode::Value
+func (r *rnode) Value() interface{} {
+	return r.n.value
+}
+
+// rnode::HasLeft
+func (r *rnode) HasLeft() bool {
+	return r.n.left != nil
+}
+
+// rnode::HasRight
+func (r *rnode) HasRight() bool {
+	return r.n.right != nil
+}
+
+// rnode::Cmp
+func (r *rnode) Cmp(a interface{}, b interface{}) int {
+	return r.fcmp(a, b)
+}
+
+// Find splays the node at which the search ended, found or not, to the root
+func (t *tree) Find(f finder.F) (key interface{}, value interface{}, found bool) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	var last *node // Last node visited
+loop:
+	for h := t.root; h != nil; {
+		last = h
+		switch action := f(&rnode{n: h, fcmp: t.fcmp}); action {
+		case finder.LEFT:
+			h = h.left
+		case finder.RIGHT:
+			h = h.right
+		case finder.FOUND:
+			found = true
+			break loop
+		case finder.NOT_FOUND:
+			break loop
+		default:
+			panic(fmt.Sprintf("illegal find action '%s'", action))
+
+		}
+	}
+	if last == nil {
+		return nil, nil, false
+	}
+	t.root = splay(t.root, last.key, t.fcmp)
+	if found {
+		return last.key, last.value, true
+	}
+	return nil, nil, false
+}
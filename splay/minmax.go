@@ -0,0 +1,45 @@
+package splay
+
+import . "github.com/iNamik/go_pkg/debug/assert"
+
+//import . "github.com/iNamik/go_pkg/debug/ping"
+
+// Min splays the minimum node to the root
+func (t *tree) Min() (interface{}, interface{}, bool) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if t.root == nil {
+		return nil, nil, false
+	}
+	t.root = splay(t.root, min(t.root).key, t.fcmp)
+	return t.root.key, t.root.value, true
+}
+
+// Max splays the maximum node to the root
+func (t *tree) Max() (interface{}, interface{}, bool) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if t.root == nil {
+		return nil, nil, false
+	}
+	t.root = splay(t.root, max(t.root).key, t.fcmp)
+	return t.root.key, t.root.value, true
+}
+
+// min
+func min(h *node) *node {
+	Assert(h != nil)
+	for h.left != nil {
+		h = h.left
+	}
+	return h
+}
+
+// max
+func max(h *node) *node {
+	Assert(h != nil)
+	for h.right != nil {
+		h = h.right
+	}
+	return h
+}
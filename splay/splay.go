@@ -0,0 +1,215 @@
+package splay
+
+import (
+	"github.com/iNamik/go_bst"
+	"github.com/iNamik/go_bst/finder"
+	"github.com/iNamik/go_bst/visitor"
+	"github.com/iNamik/go_bst/walker"
+	"github.com/iNamik/go_cmp"
+)
+
+import (
+	"sync"
+)
+
+/**********************************************************************
+ ** Types & Interfaces
+ **********************************************************************/
+
+// T
+type T interface {
+	bst.T
+	finder.I
+	visitor.I
+	walker.I
+	bst.I_Size
+	finder.I_Min
+	finder.I_Max
+}
+
+// node
+type node struct {
+	key   interface{}
+	value interface{}
+	left  *node
+	right *node
+}
+
+// tree
+type tree struct {
+	mutex *sync.Mutex
+	root  *node
+	fcmp  cmp.F
+	size  int
+}
+
+/**********************************************************************
+ ** Public Functions
+ **********************************************************************/
+
+// New
+func New(fcmp cmp.F) T {
+	return &tree{mutex: &sync.Mutex{}, root: nil, fcmp: fcmp, size: 0}
+}
+
+// tree:Empty
+func (t *tree) Empty() bool {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return t.size == 0
+}
+
+// tree:Size
+func (t *tree) Size() int {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return t.size
+}
+
+// tree:ReplaceOrInsert
+func (t *tree) ReplaceOrInsert(key interface{}, value interface{}) bool {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.root = splay(t.root, key, t.fcmp)
+	if t.root != nil && isEqual(key, t.root.key, t.fcmp) {
+		t.root.value = value
+		return true
+	}
+	t.root = insertRoot(t.root, key, value, t.fcmp)
+	t.size++
+	return false
+}
+
+// tree::Get splays key, or the last node visited while searching for it, to the root
+func (t *tree) Get(key interface{}) (interface{}, bool) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.root = splay(t.root, key, t.fcmp)
+	if t.root != nil && isEqual(key, t.root.key, t.fcmp) {
+		return t.root.value, true
+	}
+	return nil, false
+}
+
+// tree::Remove
+func (t *tree) Remove(key interface{}) bool {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.root = splay(t.root, key, t.fcmp)
+	if t.root == nil || !isEqual(key, t.root.key, t.fcmp) {
+		return false
+	}
+	t.root = removeRoot(t.root, t.fcmp)
+	t.size--
+	return true
+}
+
+/**********************************************************************
+ ** Private Functions
+ **********************************************************************/
+
+// isEqual
+func isEqual(a interface{}, b interface{}, fcmp cmp.F) bool {
+	c := fcmp(a, b)
+	return c != cmp.LT && c != cmp.GT
+}
+
+// insertRoot assumes h is the result of splaying key, and that key is
+// not in the tree.  It returns a new root holding key, with h split
+// between its children.
+func insertRoot(h *node, key interface{}, value interface{}, fcmp cmp.F) *node {
+	n := &node{key: key, value: value}
+	if h == nil {
+		return n
+	}
+	if fcmp(key, h.key) == cmp.LT {
+		n.left, n.right = h.left, h
+		h.left = nil
+	} else {
+		n.left, n.right = h, h.right
+		h.right = nil
+	}
+	return n
+}
+
+// removeRoot removes h, the root of the tree, by joining its children.
+// max(h.left) is splayed to the top of the left subtree, leaving it
+// with no right child, where h.right is then attached.
+func removeRoot(h *node, fcmp cmp.F) *node {
+	if h.left == nil {
+		return h.right
+	}
+	n := splay(h.left, max(h.left).key, fcmp)
+	n.right = h.right
+	return n
+}
+
+// splay moves the node holding key to the root of the subtree rooted
+// at h.  If key is not present, the last node visited while searching
+// for it becomes the root instead.
+// This is the top-down splay of Sleator and Tarjan, which needs
+// neither recursion nor parent pointers.
+func splay(h *node, key interface{}, fcmp cmp.F) *node {
+	if h == nil {
+		return nil
+	}
+	var header node
+	l, r := &header, &header // Max of the left tree, min of the right tree
+loop:
+	for {
+		switch fcmp(key, h.key) {
+		case cmp.LT:
+			if h.left == nil {
+				break loop
+			}
+			if fcmp(key, h.left.key) == cmp.LT {
+				h = rotateRight(h)
+				if h.left == nil {
+					break loop
+				}
+			}
+			// Link h into the right tree
+			r.left = h
+			r = h
+			h = h.left
+		case cmp.GT:
+			if h.right == nil {
+				break loop
+			}
+			if fcmp(key, h.right.key) == cmp.GT {
+				h = rotateLeft(h)
+				if h.right == nil {
+					break loop
+				}
+			}
+			// Link h into the left tree
+			l.right = h
+			l = h
+			h = h.right
+		default:
+			break loop
+		}
+	}
+	// Reassemble
+	l.right = h.left
+	r.left = h.right
+	h.left = header.right
+	h.right = header.left
+	return h
+}
+
+// rotateLeft
+func rotateLeft(h *node) *node {
+	x := h.right
+	h.right = x.left
+	x.left = h
+	return x
+}
+
+// rotateRight
+func rotateRight(h *node) *node {
+	x := h.left
+	h.left = x.right
+	x.right = h
+	return x
+}
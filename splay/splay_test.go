@@ -0,0 +1,175 @@
+package splay
+
+import (
+	"testing"
+)
+
+import (
+	"github.com/iNamik/go_bst/finder"
+	"github.com/iNamik/go_bst/visitor"
+	"github.com/iNamik/go_bst/walker"
+	"github.com/iNamik/go_cmp"
+)
+
+/**********************************************************************
+ ** Test Functions
+ **********************************************************************/
+
+// Test_Empty
+func Test_Empty(t *testing.T) {
+	r := New(cmp.F_int)
+	assertEmpty(r, true, t)
+	assertSize(r, 0, t)
+	assertReplaceOrInsert(r, key1, key1, false, t)
+	assertEmpty(r, false, t)
+	assertSize(r, 1, t)
+}
+
+// Test_Replace
+func Test_Replace(t *testing.T) {
+	r := New(cmp.F_int)
+	assertReplaceOrInsert(r, key1, key1, false, t)
+	assertReplaceOrInsert(r, key1, key2, true, t)
+	assertGet(r, key1, key2, true, t)
+	assertSize(r, 1, t)
+}
+
+// Test_Get_NotFound
+func Test_Get_NotFound(t *testing.T) {
+	r := New(cmp.F_int)
+	assertGet(r, key1, nil, false, t)
+	assertReplaceOrInsert(r, key1, key1, false, t)
+	assertGet(r, key2, nil, false, t)
+}
+
+// Test_Remove
+func Test_Remove(t *testing.T) {
+	r := New(cmp.F_int)
+	assertRemove(r, key1, false, t)
+	assertReplaceOrInsert(r, key2, key2, false, t)
+	assertRemove(r, key1, false, t)
+	assertRemove(r, key3, false, t)
+	assertRemove(r, key2, true, t)
+	assertEmpty(r, true, t)
+}
+
+// Test_Min_Max
+func Test_Min_Max(t *testing.T) {
+	r := New(cmp.F_int)
+	assertKVF(-1, -1, false, r.Min, t)
+	assertKVF(-1, -1, false, r.Max, t)
+	r = randomTree(1000)
+	assertKVF(0, 0, true, r.Min, t)
+	assertRoot(r, 0, t)
+	assertKVF(999, 999, true, r.Max, t)
+	assertRoot(r, 999, t)
+	assertSize(r, 1000, t)
+}
+
+// Test_Splay_Insert
+func Test_Splay_Insert(t *testing.T) {
+	r := randomTree(1000)
+	assertReplaceOrInsert(r, 1000, 1000, false, t)
+	assertRoot(r, 1000, t)
+	assertReplaceOrInsert(r, 500, 500, true, t)
+	assertRoot(r, 500, t)
+	assertBST(r, t)
+}
+
+// Test_Splay_Get
+func Test_Splay_Get(t *testing.T) {
+	r := randomTreeDouble(1000)
+	assertGet(r, 500, 500, true, t)
+	assertRoot(r, 500, t)
+	// Not found, so one of its neighbors is splayed instead
+	assertGet(r, 501, nil, false, t)
+	if k := r.(*tree).root.key; k != 500 && k != 502 {
+		t.Fatalf("root is '%v' instead of '500' or '502'", k)
+	}
+	assertBST(r, t)
+}
+
+// Test_Splay_Find
+func Test_Splay_Find(t *testing.T) {
+	r := randomTreeDouble(1000)
+	assertKVF(1000, 1000, true, func() (interface{}, interface{}, bool) { return finder.LowerBound(r, 1001) }, t)
+	// LowerBound(1001) ends its search at either 1000 or 1002
+	if k := r.(*tree).root.key; k != 1000 && k != 1002 {
+		t.Fatalf("root is '%v' instead of '1000' or '1002'", k)
+	}
+	assertKVF(0, 0, true, func() (interface{}, interface{}, bool) { return finder.Min(r) }, t)
+	assertRoot(r, 0, t)
+	assertBST(r, t)
+}
+
+// Test_Splay_Visit
+func Test_Splay_Visit(t *testing.T) {
+	r := randomTree(1000)
+	r.Visit(500, func(v interface{}, _ bool) (interface{}, visitor.Action) {
+		return nil, visitor.GET
+	})
+	assertRoot(r, 500, t)
+	r.Visit(1000, func(v interface{}, _ bool) (interface{}, visitor.Action) {
+		return 1000, visitor.INSERT
+	})
+	assertRoot(r, 1000, t)
+	assertBST(r, t)
+}
+
+// Test_Walk_Shape confirms that walking does not change the shape of the tree
+func Test_Walk_Shape(t *testing.T) {
+	r := randomTree(1000)
+	before := r.(*tree).root
+	beforeLeft := before.left
+	i := 0
+	walker.ForeachMin(r, func(k interface{}, v interface{}) {
+		if k.(int) != i {
+			t.Fatalf("encountered '%d' instead of '%d", k, i)
+		}
+		i++
+	})
+	if r.(*tree).root != before || r.(*tree).root.left != beforeLeft {
+		t.Fatalf("walk changed the shape of the tree")
+	}
+}
+
+// Test_Sorted_Access confirms that accessing keys in order, which is the
+// worst case for building the tree, stays correct
+func Test_Sorted_Access(t *testing.T) {
+	const SIZE = 1 << 16
+	r := New(cmp.F_int)
+	for i := 0; i < SIZE; i++ {
+		assertReplaceOrInsert(r, i, i, false, t)
+	}
+	for i := 0; i < SIZE; i++ {
+		assertGet(r, i, i, true, t)
+	}
+	assertSize(r, SIZE, t)
+	assertBST(r, t)
+}
+
+// Test_Tree_Large_RandomInsertRemove
+func Test_Tree_Large_RandomInsertRemove(t *testing.T) {
+	const SIZE = 1000
+	const COUNT = 100000
+	var array [SIZE]bool
+	r := New(cmp.F_int)
+	size := 0
+	for i := 0; i < COUNT; i++ {
+		n := random.Intn(SIZE)
+		assertGet(r, n, nil, array[n], t)
+		if array[n] == false {
+			assertReplaceOrInsert(r, n, nil, false, t)
+			size++
+		} else {
+			assertRemove(r, n, true, t)
+			size--
+		}
+		array[n] = !array[n]
+		if i%1000 == 0 {
+			assertBST(r, t)
+		}
+	}
+	assertSize(r, size, t)
+	assertBST(r, t)
+}
@@ -0,0 +1,44 @@
+package splay
+
+import "fmt"
+
+import (
+	"github.com/iNamik/go_bst/visitor"
+)
+
+// tree::Visit splays key, or the last node visited while searching for it, to the root
+// before calling f.  Any INSERT or REMOVE action is then performed at the root.
+func (t *tree) Visit(key interface{}, f visitor.F) (value interface{}, result visitor.Result) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.root = splay(t.root, key, t.fcmp)
+	var action visitor.Action
+	if t.root == nil || !isEqual(key, t.root.key, t.fcmp) {
+		value, action = f(nil, false)
+		switch action {
+		case visitor.INSERT:
+			t.root = insertRoot(t.root, key, value, t.fcmp)
+			t.size++
+			return value, visitor.INSERTED
+		case visitor.GET:
+			return nil, visitor.NOT_FOUND
+		default:
+			panic(fmt.Sprintf("illegal action '%s' when visiting non-found key", action))
+		}
+	}
+	value, action = f(t.root.value, true)
+	switch action {
+	case visitor.GET:
+		return t.root.value, visitor.FOUND
+	case visitor.REPLACE:
+		t.root.value = value
+		return value, visitor.REPLACED
+	case visitor.REMOVE:
+		value = t.root.value
+		t.root = removeRoot(t.root, t.fcmp)
+		t.size--
+		return value, visitor.REMOVED
+	default:
+		panic(fmt.Sprintf("illegal action '%s' when visiting found key", action))
+	}
+}
@@ -0,0 +1,123 @@
+package splay
+
+import (
+	"testing"
+)
+
+import (
+	"github.com/iNamik/go_bst/finder"
+	"github.com/iNamik/go_bst/walker"
+	"github.com/iNamik/go_cmp"
+)
+
+/**********************************************************************
+ ** Test Functions
+ **********************************************************************/
+
+// Test_Find_LowerBound
+func Test_Find_LowerBound(t *testing.T) {
+	r := randomTreeDouble(1000)
+	assertKVF(1000, 1000, true, func() (interface{}, interface{}, bool) { return finder.LowerBound(r, 1001) }, t)
+	assertKVF(-1, -1, false, func() (interface{}, interface{}, bool) { return finder.LowerBound(r, -1) }, t)
+}
+
+// Test_Find_UpperBound
+func Test_Find_UpperBound(t *testing.T) {
+	r := randomTreeDouble(1000)
+	assertKVF(1002, 1002, true, func() (interface{}, interface{}, bool) { return finder.UpperBound(r, 1001) }, t)
+	assertKVF(-1, -1, false, func() (interface{}, interface{}, bool) { return finder.UpperBound(r, 2001) }, t)
+}
+
+// Test_Walk_Empty
+func Test_Walk_Empty(t *testing.T) {
+	r := New(cmp.F_int)
+	r.Walk(func(n walker.Node) walker.Action {
+		t.Fatal("walk() called")
+		return walker.RETURN
+	})
+}
+
+// Test_Walk_Foreach_Min
+func Test_Walk_Foreach_Min(t *testing.T) {
+	const SIZE = 1000
+	r := randomTree(SIZE)
+	i := 0
+	walker.ForeachMin(r, func(k interface{}, v interface{}) {
+		if k.(int) != i {
+			t.Fatalf("encountered '%d' instead of '%d", k, i)
+		}
+		i++
+	})
+	if i != SIZE {
+		t.Fatalf("visited %d keys instead of %d", i, SIZE)
+	}
+}
+
+// Test_Walk_Foreach_Max
+func Test_Walk_Foreach_Max(t *testing.T) {
+	const SIZE = 1000
+	r := randomTree(SIZE)
+	i := SIZE - 1
+	walker.ForeachMax(r, func(k interface{}, v interface{}) {
+		if k.(int) != i {
+			t.Fatalf("encountered '%d' instead of '%d", k, i)
+		}
+		i--
+	})
+	if i != -1 {
+		t.Fatalf("visited %d keys instead of %d", SIZE-1-i, SIZE)
+	}
+}
+
+// Test_Walk_Parent
+func Test_Walk_Parent(t *testing.T) {
+	r := New(cmp.F_int)
+	// The last key inserted becomes the root, so 2 is the root with children 1 and 3
+	r.ReplaceOrInsert(1, 1)
+	r.ReplaceOrInsert(3, 3)
+	r.ReplaceOrInsert(2, 2)
+	var visited []int
+	r.Walk(func(n walker.Node) walker.Action {
+		visited = append(visited, n.Key().(int))
+		switch len(visited) {
+		case 1:
+			return walker.LEFT
+		case 2:
+			return walker.PARENT
+		case 3:
+			return walker.RIGHT
+		}
+		return walker.RETURN
+	})
+	expected := []int{2, 1, 2, 3}
+	if len(visited) != len(expected) {
+		t.Fatalf("visited %v instead of %v", visited, expected)
+	}
+	for i := range expected {
+		if visited[i] != expected[i] {
+			t.Fatalf("visited %v instead of %v", visited, expected)
+		}
+	}
+}
+
+// Test_Walk_Exception_Left
+func Test_Walk_Exception_Left(t *testing.T) {
+	r := New(cmp.F_int)
+	r.ReplaceOrInsert(key1, key1)
+	assertPanic(t, "cannot walk left when hasLeft() == false", func() {
+		r.Walk(func(n walker.Node) walker.Action {
+			return walker.LEFT
+		})
+	})
+}
+
+// Test_Walk_Exception_Illegal
+func Test_Walk_Exception_Illegal(t *testing.T) {
+	r := New(cmp.F_int)
+	r.ReplaceOrInsert(key1, key1)
+	assertPanic(t, "illegal walk action 'walker.Action(-1)'", func() {
+		r.Walk(func(n walker.Node) walker.Action {
+			return walker.Action(-1)
+		})
+	})
+}
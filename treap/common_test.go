@@ -0,0 +1,165 @@
+package treap
+
+import (
+	"math/rand"
+	"testing"
+)
+
+import (
+	"github.com/iNamik/go_cmp"
+)
+
+/**********************************************************************
+ ** Random Source
+ **********************************************************************/
+
+// SEED is used for all randomness in the tests, so that any
+// failure, including the shape of the tree, is reproducible
+const SEED = 1
+
+// random
+var random = rand.New(rand.NewSource(SEED))
+
+/**********************************************************************
+ ** Test Data
+ **********************************************************************/
+
+const key1 = 1
+const key2 = 2
+const key3 = 3
+
+/**********************************************************************
+ ** Assert Functions
+ **********************************************************************/
+
+// assertEmpty
+func assertEmpty(r T, empty bool, t *testing.T) {
+	if empty_ := r.Empty(); empty_ != empty {
+		t.Fatalf("Empty() returned %v instead of %v", empty_, empty)
+	}
+}
+
+// assertSize
+func assertSize(r T, size int, t *testing.T) {
+	if size_ := r.Size(); size_ != size {
+		t.Fatalf("Size() returned %v instead of %v", size_, size)
+	}
+}
+
+// assertReplaceOrInsert
+func assertReplaceOrInsert(r T, key int, value interface{}, replaced bool, t *testing.T) {
+	if replaced_ := r.ReplaceOrInsert(key, value); replaced_ != replaced {
+		t.Fatalf("ReplaceOrInsert(%v) returned %v instead of %v", key, replaced_, replaced)
+	}
+}
+
+// assertGet
+func assertGet(r T, key int, value_ interface{}, found bool, t *testing.T) {
+	v_, found_ := r.Get(key)
+	if found_ != found {
+		t.Fatalf("Get() returned %v", found_)
+	}
+	if found == true {
+		if v_ != value_ {
+			t.Fatalf("Get() returned value '%v' instead of '%v'", v_, value_)
+		}
+	}
+}
+
+// assertRemove
+func assertRemove(r T, key interface{}, removed bool, t *testing.T) {
+	if removed_ := r.Remove(key); removed_ != removed {
+		t.Fatalf("Remove(%v) returned %v instead of %v", key, removed_, removed)
+	}
+}
+
+// assertKVF calls a func of type func()(key,value,found) and confirms the results
+func assertKVF(key int, value_ interface{}, found bool, f func() (interface{}, interface{}, bool), t *testing.T) {
+	k_, v_, found_ := f()
+	if found_ != found {
+		t.Fatalf("func returned %v", found_)
+	}
+	if found == true {
+		k, ok := k_.(int)
+		if ok == false {
+			t.Fatal("func did not return key of type int")
+		}
+		if k != key {
+			t.Fatalf("func returned key '%d' instead of '%d'", k, key)
+		}
+		if v_ != value_ {
+			t.Fatalf("func returned value '%v' instead of '%v'", v_, value_)
+		}
+	}
+}
+
+// assertPanic
+func assertPanic(t *testing.T, msg string, f func()) {
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Fatal("assertPanic: did not generate panic()")
+		} else if r != msg {
+			t.Fatalf("assertPanic: recover() recieved message '%s' instead of '%s'", r, msg)
+		}
+	}()
+	f()
+}
+
+// assertTreap confirms that the tree is a BST ordered by key
+// and a heap ordered by priority
+func assertTreap(r T, t *testing.T) {
+	if !isTreap(r.(*tree).root, nil, nil, t) {
+		t.Fatalf("tree is not a treap")
+	}
+}
+
+/**********************************************************************
+ ** Helper Functions
+ **********************************************************************/
+
+// newTree creates a treap with reproducible priorities
+func newTree() T {
+	return NewWithPriority(cmp.F_int, RandomPriority(rand.NewSource(SEED)))
+}
+
+// randomTree
+func randomTree(n int) T {
+	r := newTree()
+	for _, i := range random.Perm(n) {
+		r.ReplaceOrInsert(i, i)
+	}
+	return r
+}
+
+// randomTreeDouble
+func randomTreeDouble(n int) T {
+	r := newTree()
+	for _, i_ := range random.Perm(n) {
+		i := i_ + i_
+		r.ReplaceOrInsert(i, i)
+	}
+	return r
+}
+
+/**********************************************************************
+ ** Integritry Functions
+ **********************************************************************/
+
+// isTreap returns whether the subtree rooted at x has all keys strictly
+// between min and max (nil meaning unbounded), and no child with a
+// greater priority than its parent
+func isTreap(x *node, min interface{}, max interface{}, t *testing.T) bool {
+	if x == nil {
+		return true
+	}
+	if (min != nil && cmp.F_int(x.key, min) != cmp.GT) || (max != nil && cmp.F_int(x.key, max) != cmp.LT) {
+		t.Errorf("key %v is out of order", x.key)
+		return false
+	}
+	if (x.left != nil && x.left.priority > x.priority) || (x.right != nil && x.right.priority > x.priority) {
+		t.Errorf("key %v has a child with a greater priority", x.key)
+		return false
+	}
+	return isTreap(x.left, min, x.key, t) && isTreap(x.right, x.key, max, t)
+}
@@ -0,0 +1,105 @@
+/*
+
+Package treap provides a randomized, self-balancing implementation
+of an extensible Binary Search Tree as defined in the go_bst
+package and sub-packages.
+
+Each key is assigned a priority when it is inserted, and the tree
+is kept both ordered by key and heap-ordered by priority.  With
+random priorities, the expected height of the tree is O(log n)
+regardless of the order in which keys are inserted.
+
+
+Standard BST Methods
+--------------------
+
+All of the standard BST methods required to satisfy the
+bst.T interface have been implemented:
+
+ * Empty
+ * ReplaceOrInsert
+ * Get
+ * Remove
+
+
+Extensible BST Methods
+----------------------
+
+All of the extensible interfaces have been implemented:
+
+ * Find  (see finder.T)
+ * Visit (see visitor.T)
+ * Walk  (see walker.T)
+
+
+Additional BST Methods
+----------------------
+
+The following additional BST methods have been implemented:
+
+ * Size (see bst.I_Size)
+ * Min  (see finder.I_Min)
+ * Max  (see finder.I_Max)
+
+
+Priorities
+----------
+
+New draws priorities from a time-seeded random source.
+
+NewWithPriority accepts an F_Priority function, which allows
+the source of priorities to be chosen by the caller:
+
+ * RandomPriority(rand.NewSource(seed)) draws priorities from a
+   seeded source, so a given sequence of operations always
+   produces the same tree shape.
+
+ * A deterministic hash of the key produces the same tree shape
+   for a given set of keys, regardless of insertion order.
+
+The F_Priority function is only ever called while the tree is
+locked, so a non-thread-safe source may be used, as long as it
+is not shared.
+
+
+Balancing
+---------
+
+ReplaceOrInsert, and Visit's INSERT action, rotate a new node
+up until its parent has a greater priority.
+
+Remove, and Visit's REMOVE action, rotate a node down, always
+promoting the child with the greater priority, until it can
+simply be dropped.
+
+
+Effeciency
+----------
+
+Instead of storing parent and sibling information on each node,
+this implementation uses recursion to accomplish various tree
+navigation functions.  Specifically, the following functions
+use recursion:
+
+ * ReplaceOrInsert
+ * Remove
+ * Visit
+ * Walk
+
+The expected depth of the recursion is O(log n).
+
+
+License
+-------
+
+This package is released under the MIT License.
+See included file 'LICENSE' for more details.
+
+
+Contributors
+------------
+
+David Farell <DavidPFarrell@yahoo.com>
+
+*/
+package treap
@@ -0,0 +1,222 @@
+package treap
+
+import (
+	"github.com/iNamik/go_bst"
+	"github.com/iNamik/go_bst/finder"
+	"github.com/iNamik/go_bst/visitor"
+	"github.com/iNamik/go_bst/walker"
+	"github.com/iNamik/go_cmp"
+)
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+)
+
+/**********************************************************************
+ ** Types & Interfaces
+ **********************************************************************/
+
+// T
+type T interface {
+	bst.T
+	finder.I
+	visitor.I
+	walker.I
+	bst.I_Size
+	finder.I_Min
+	finder.I_Max
+}
+
+// F_Priority defines the function used to assign a priority to a
+// newly inserted key.  Nodes with greater priorities are kept closer
+// to the root of the tree.
+type F_Priority func(key interface{}) int
+
+// node
+type node struct {
+	key      interface{}
+	value    interface{}
+	left     *node
+	right    *node
+	priority int
+}
+
+// tree
+type tree struct {
+	mutex *sync.Mutex
+	root  *node
+	fcmp  cmp.F
+	fpri  F_Priority
+	size  int
+}
+
+/**********************************************************************
+ ** Public Functions
+ **********************************************************************/
+
+// New creates a treap whose priorities are drawn from a
+// time-seeded random source
+func New(fcmp cmp.F) T {
+	return NewWithPriority(fcmp, RandomPriority(rand.NewSource(time.Now().UTC().UnixNano())))
+}
+
+// NewWithPriority creates a treap whose priorities are assigned by fpri.
+// fpri is only ever called while the tree is locked.
+func NewWithPriority(fcmp cmp.F, fpri F_Priority) T {
+	return &tree{mutex: &sync.Mutex{}, root: nil, fcmp: fcmp, fpri: fpri, size: 0}
+}
+
+// RandomPriority returns an F_Priority that draws priorities from src.
+// Using a source with a fixed seed produces reproducible tree shapes.
+// src should not be shared with other trees or goroutines.
+func RandomPriority(src rand.Source) F_Priority {
+	r := rand.New(src)
+	return func(_ interface{}) int {
+		return r.Int()
+	}
+}
+
+// tree:Empty
+func (t *tree) Empty() bool {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return t.size == 0
+}
+
+// tree:Size
+func (t *tree) Size() int {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return t.size
+}
+
+// tree:ReplaceOrInsert
+func (t *tree) ReplaceOrInsert(key interface{}, value interface{}) bool {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	var replaced bool
+	t.root, replaced = replaceOrInsert(t.root, key, value, t.fcmp, t.fpri)
+	if !replaced {
+		t.size++
+	}
+	return replaced
+}
+
+// tree::Get
+func (t *tree) Get(key interface{}) (interface{}, bool) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	h := get(t.root, key, t.fcmp)
+	if h != nil {
+		return h.value, true
+	}
+	return nil, false
+}
+
+// tree::Remove
+func (t *tree) Remove(key interface{}) bool {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	var removed bool
+	t.root, removed = remove(t.root, key, t.fcmp)
+	if removed {
+		t.size--
+	}
+	return removed
+}
+
+/**********************************************************************
+ ** Private Functions
+ **********************************************************************/
+
+// replaceOrInsert returns true if key was replaced, false if it was inserted into the tree
+func replaceOrInsert(h *node, key interface{}, value interface{}, fcmp cmp.F, fpri F_Priority) (*node, bool) {
+	if h == nil {
+		return &node{key: key, value: value, priority: fpri(key)}, false
+	}
+	replaced := true
+	switch fcmp(key, h.key) {
+	case cmp.LT:
+		h.left, replaced = replaceOrInsert(h.left, key, value, fcmp, fpri)
+		if h.left.priority > h.priority {
+			h = rotateRight(h)
+		}
+	case cmp.GT:
+		h.right, replaced = replaceOrInsert(h.right, key, value, fcmp, fpri)
+		if h.right.priority > h.priority {
+			h = rotateLeft(h)
+		}
+	default:
+		h.value = value
+	}
+	return h, replaced
+}
+
+// get
+func get(h *node, key interface{}, fcmp cmp.F) *node {
+	for h != nil {
+		switch fcmp(key, h.key) {
+		case cmp.LT:
+			h = h.left
+		case cmp.GT:
+			h = h.right
+		default:
+			return h
+		}
+	}
+	return nil
+}
+
+// remove
+func remove(h *node, key interface{}, fcmp cmp.F) (*node, bool) {
+	removed := false
+	if h != nil {
+		switch fcmp(key, h.key) {
+		case cmp.LT:
+			h.left, removed = remove(h.left, key, fcmp)
+		case cmp.GT:
+			h.right, removed = remove(h.right, key, fcmp)
+		default:
+			h = removeNode(h)
+			removed = true
+		}
+	}
+	return h, removed
+}
+
+// removeNode rotates h down, always promoting its child with the
+// greater priority, until h is a leaf that can simply be dropped
+func removeNode(h *node) *node {
+	if h.left == nil {
+		return h.right
+	}
+	if h.right == nil {
+		return h.left
+	}
+	if h.left.priority > h.right.priority {
+		h = rotateRight(h)
+		h.right = removeNode(h.right)
+	} else {
+		h = rotateLeft(h)
+		h.left = removeNode(h.left)
+	}
+	return h
+}
+
+// rotateLeft
+func rotateLeft(h *node) *node {
+	x := h.right
+	h.right = x.left
+	x.left = h
+	return x
+}
+
+// rotateRight
+func rotateRight(h *node) *node {
+	x := h.left
+	h.left = x.right
+	x.right = h
+	return x
+}
@@ -0,0 +1,175 @@
+package treap
+
+import (
+	"math/rand"
+	"testing"
+)
+
+import (
+	"github.com/iNamik/go_cmp"
+)
+
+/**********************************************************************
+ ** Test Functions
+ **********************************************************************/
+
+// Test_Empty
+func Test_Empty(t *testing.T) {
+	r := New(cmp.F_int)
+	assertEmpty(r, true, t)
+	assertSize(r, 0, t)
+	assertReplaceOrInsert(r, key1, key1, false, t)
+	assertEmpty(r, false, t)
+	assertSize(r, 1, t)
+}
+
+// Test_Replace
+func Test_Replace(t *testing.T) {
+	r := newTree()
+	assertReplaceOrInsert(r, key1, key1, false, t)
+	assertReplaceOrInsert(r, key1, key2, true, t)
+	assertGet(r, key1, key2, true, t)
+	assertSize(r, 1, t)
+}
+
+// Test_Get_NotFound
+func Test_Get_NotFound(t *testing.T) {
+	r := newTree()
+	assertGet(r, key1, nil, false, t)
+	assertReplaceOrInsert(r, key1, key1, false, t)
+	assertGet(r, key2, nil, false, t)
+}
+
+// Test_Remove
+func Test_Remove(t *testing.T) {
+	r := newTree()
+	assertRemove(r, key1, false, t)
+	assertReplaceOrInsert(r, key2, key2, false, t)
+	assertRemove(r, key1, false, t)
+	assertRemove(r, key3, false, t)
+	assertRemove(r, key2, true, t)
+	assertEmpty(r, true, t)
+}
+
+// Test_Min_Max
+func Test_Min_Max(t *testing.T) {
+	r := newTree()
+	assertKVF(-1, -1, false, r.Min, t)
+	assertKVF(-1, -1, false, r.Max, t)
+	r = randomTree(1000)
+	assertKVF(0, 0, true, r.Min, t)
+	assertKVF(999, 999, true, r.Max, t)
+}
+
+// Test_Priority_Reproducible confirms that the same priority source
+// and the same inserts produce the same tree shape
+func Test_Priority_Reproducible(t *testing.T) {
+	const SIZE = 1000
+	perm := random.Perm(SIZE)
+	r1 := newTree()
+	r2 := newTree()
+	for _, i := range perm {
+		r1.ReplaceOrInsert(i, i)
+		r2.ReplaceOrInsert(i, i)
+	}
+	if !sameShape(r1.(*tree).root, r2.(*tree).root) {
+		t.Fatalf("trees do not have the same shape")
+	}
+}
+
+// Test_Priority_Hash confirms that a deterministic priority function
+// produces the same tree shape regardless of insertion order
+func Test_Priority_Hash(t *testing.T) {
+	const SIZE = 1000
+	hash := func(key interface{}) int {
+		return int(uint32(key.(int)) * 2654435761) // Knuth's multiplicative hash
+	}
+	r1 := NewWithPriority(cmp.F_int, hash)
+	r2 := NewWithPriority(cmp.F_int, hash)
+	for i := 0; i < SIZE; i++ {
+		r1.ReplaceOrInsert(i, i)
+	}
+	for _, i := range random.Perm(SIZE) {
+		r2.ReplaceOrInsert(i, i)
+	}
+	assertTreap(r1, t)
+	if !sameShape(r1.(*tree).root, r2.(*tree).root) {
+		t.Fatalf("trees do not have the same shape")
+	}
+}
+
+// Test_Sorted_Insert confirms that inserting keys in order does not
+// degenerate the tree into a list
+func Test_Sorted_Insert(t *testing.T) {
+	const SIZE = 1 << 16
+	r := newTree()
+	for i := 0; i < SIZE; i++ {
+		assertReplaceOrInsert(r, i, i, false, t)
+	}
+	assertSize(r, SIZE, t)
+	assertTreap(r, t)
+	// The expected height is ~3*log2(n), so this leaves plenty of headroom
+	if h := height(r.(*tree).root); h > 4*16 {
+		t.Fatalf("tree has height %d, which exceeds %d", h, 4*16)
+	}
+}
+
+// Test_Tree_Large_RandomInsertRemove
+func Test_Tree_Large_RandomInsertRemove(t *testing.T) {
+	const SIZE = 1000
+	const COUNT = 100000
+	var array [SIZE]bool
+	r := newTree()
+	size := 0
+	for i := 0; i < COUNT; i++ {
+		n := random.Intn(SIZE)
+		if array[n] == false {
+			assertReplaceOrInsert(r, n, i, false, t)
+			size++
+		} else {
+			assertRemove(r, n, true, t)
+			size--
+		}
+		array[n] = !array[n]
+		if i%1000 == 0 {
+			assertTreap(r, t)
+		}
+	}
+	assertSize(r, size, t)
+	assertTreap(r, t)
+}
+
+// Test_RandomPriority_Source
+func Test_RandomPriority_Source(t *testing.T) {
+	f1 := RandomPriority(rand.NewSource(SEED))
+	f2 := RandomPriority(rand.NewSource(SEED))
+	for i := 0; i < 100; i++ {
+		if p1, p2 := f1(i), f2(i); p1 != p2 {
+			t.Fatalf("priority %d differs from %d", p1, p2)
+		}
+	}
+}
+
+/**********************************************************************
+ ** Helper Functions
+ **********************************************************************/
+
+// height
+func height(h *node) int {
+	if h == nil {
+		return 0
+	}
+	hl, hr := height(h.left), height(h.right)
+	if hl > hr {
+		return hl + 1
+	}
+	return hr + 1
+}
+
+// sameShape
+func sameShape(a *node, b *node) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.key == b.key && sameShape(a.left, b.left) && sameShape(a.right, b.right)
+}
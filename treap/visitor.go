@@ -0,0 +1,67 @@
+package treap
+
+import "fmt"
+
+import (
+	"github.com/iNamik/go_bst/visitor"
+	"github.com/iNamik/go_cmp"
+)
+
+// tree::Visit
+func (t *tree) Visit(key interface{}, f visitor.F) (value interface{}, result visitor.Result) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.root, value, result = visit(t.root, key, t.fcmp, t.fpri, f)
+	if result == visitor.INSERTED {
+		t.size++
+	} else if result == visitor.REMOVED {
+		t.size--
+	}
+	return value, result
+}
+
+// visit
+func visit(h *node, key interface{}, fcmp cmp.F, fpri F_Priority, f visitor.F) (_ *node, value interface{}, result visitor.Result) {
+	if h == nil {
+		var action visitor.Action
+		value, action = f(nil, false)
+		switch action {
+		case visitor.INSERT:
+			return &node{key: key, value: value, priority: fpri(key)}, value, visitor.INSERTED
+		case visitor.GET:
+			return nil, nil, visitor.NOT_FOUND
+		default:
+			panic(fmt.Sprintf("illegal action '%s' when visiting non-found key", action))
+		}
+	}
+	switch fcmp(key, h.key) {
+	case cmp.LT:
+		h.left, value, result = visit(h.left, key, fcmp, fpri, f)
+		if h.left != nil && h.left.priority > h.priority {
+			h = rotateRight(h)
+		}
+	case cmp.GT:
+		h.right, value, result = visit(h.right, key, fcmp, fpri, f)
+		if h.right != nil && h.right.priority > h.priority {
+			h = rotateLeft(h)
+		}
+	default:
+		var action visitor.Action
+		value, action = f(h.value, true)
+		switch action {
+		case visitor.GET:
+			value = h.value
+			result = visitor.FOUND
+		case visitor.REPLACE:
+			h.value = value
+			result = visitor.REPLACED
+		case visitor.REMOVE:
+			value = h.value
+			h = removeNode(h)
+			result = visitor.REMOVED
+		default:
+			panic(fmt.Sprintf("illegal action '%s' when visiting found key", action))
+		}
+	}
+	return h, value, result
+}
@@ -0,0 +1,96 @@
+/*
+
+Package typed provides a type-safe facade, using Go generics, over
+the interface{} based API declared in the go_bst package and
+sub-packages.
+
+A Tree[K, V] wraps any implementation of the extensible BST methods
+(simple, avl, redblack, etc), so that keys and values are passed and
+returned as K and V, and call-backs receive typed views of the
+finder and walker nodes.  This removes the type assertions otherwise
+needed at every call site.
+
+
+Creating a Tree
+---------------
+
+ * New        (a simple.T ordered by a func(a, b K) int)
+ * NewOrdered (a simple.T ordered by cmp.Compare, for cmp.Ordered keys)
+ * Wrap       (any implementation satisfying typed.I)
+
+Cmp adapts a func(a, b K) int into a cmp.F, so that any implementation
+can be created for use with Wrap:
+
+	t := typed.Wrap[string, int](avl.New(typed.Cmp(strings.Compare)))
+
+
+Standard BST Methods
+--------------------
+
+ * Empty
+ * Size
+ * ReplaceOrInsert
+ * Get
+ * Remove
+
+
+Extensible BST Methods
+----------------------
+
+ * Find  (with F_Find and FinderNode)
+ * Visit (with F_Visit)
+ * Walk  (with F_Walk and WalkerNode)
+
+The Action and Result types of the finder, visitor and walker
+packages are used as-is.
+
+
+Additional BST Methods
+----------------------
+
+The following additional BST methods are provided on top of the
+extensible methods:
+
+ * Min, Max, LowerBound, UpperBound (see finder.T)
+ * GetOrInsert, GetAndReplace, GetAndRemove, Replace (see visitor.T)
+ * ForeachMin, ForeachMax, ForeachRange, ForeachRangeReverse (see walker.T)
+ * ForeachMinWhile, ForeachMaxWhile, ForeachRangeWhile, ForeachRangeReverseWhile (see walker.T)
+ * All, Backward, Range, RangeBackward (see walker.I_Seq)
+
+The iterators yield typed keys and values:
+
+	for k, v := range t.All() {
+		...
+	}
+
+
+Nil Values
+----------
+
+A nil key or value in the backing tree, such as the key returned
+when Find does not find anything, is returned as the zero value of
+K or V.
+
+
+Efficiency
+----------
+
+Keys and values are still stored as interface{} by the backing
+tree, so non-pointer keys and values are boxed on insert.  The
+facade adds one type assertion per key or value returned.
+
+
+License
+-------
+
+This package is released under the MIT License.
+See included file 'LICENSE' for more details.
+
+
+Contributors
+------------
+
+David Farell <DavidPFarrell@yahoo.com>
+
+*/
+package typed
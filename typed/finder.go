@@ -0,0 +1,89 @@
+package typed
+
+import (
+	"github.com/iNamik/go_bst/finder"
+)
+
+/**********************************************************************
+ ** Types
+ **********************************************************************/
+
+// F_Find defines the typed call-back function used for the Find method
+type F_Find[K, V any] func(FinderNode[K, V]) finder.Action
+
+// FinderNode is a typed view of finder.Node
+type FinderNode[K, V any] interface {
+	Key() K
+	Value() V
+	Cmp(a K, b K) int
+	HasLeft() bool
+	HasRight() bool
+}
+
+// finderNode
+type finderNode[K, V any] struct{ n finder.Node }
+
+/**********************************************************************
+ ** Tree Methods
+ **********************************************************************/
+
+// Tree::Find (see finder.I)
+func (t *Tree[K, V]) Find(f F_Find[K, V]) (key K, value V, found bool) {
+	key_, value_, found := t.i.Find(func(n finder.Node) finder.Action {
+		return f(finderNode[K, V]{n})
+	})
+	return as[K](key_), as[V](value_), found
+}
+
+// Tree::Min (see finder.I_Min)
+func (t *Tree[K, V]) Min() (key K, value V, found bool) {
+	key_, value_, found := finder.Min(t.i)
+	return as[K](key_), as[V](value_), found
+}
+
+// Tree::Max (see finder.I_Max)
+func (t *Tree[K, V]) Max() (key K, value V, found bool) {
+	key_, value_, found := finder.Max(t.i)
+	return as[K](key_), as[V](value_), found
+}
+
+// Tree::LowerBound (see finder.I_LowerBound)
+func (t *Tree[K, V]) LowerBound(boundKey K) (key K, value V, found bool) {
+	key_, value_, found := finder.LowerBound(t.i, boundKey)
+	return as[K](key_), as[V](value_), found
+}
+
+// Tree::UpperBound (see finder.I_UpperBound)
+func (t *Tree[K, V]) UpperBound(boundKey K) (key K, value V, found bool) {
+	key_, value_, found := finder.UpperBound(t.i, boundKey)
+	return as[K](key_), as[V](value_), found
+}
+
+/**********************************************************************
+ ** FinderNode
+ **********************************************************************/
+
+// finderNode::Key
+func (n finderNode[K, V]) Key() K {
+	return as[K](n.n.Key())
+}
+
+// finderNode::Value
+func (n finderNode[K, V]) Value() V {
+	return as[V](n.n.Value())
+}
+
+// finderNode::Cmp
+func (n finderNode[K, V]) Cmp(a K, b K) int {
+	return n.n.Cmp(a, b)
+}
+
+// finderNode::HasLeft
+func (n finderNode[K, V]) HasLeft() bool {
+	return n.n.HasLeft()
+}
+
+// finderNode::HasRight
+func (n finderNode[K, V]) HasRight() bool {
+	return n.n.HasRight()
+}
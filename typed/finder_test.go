@@ -0,0 +1,76 @@
+package typed
+
+import (
+	"testing"
+)
+
+import (
+	"github.com/iNamik/go_bst/finder"
+)
+
+/**********************************************************************
+ ** Test Functions
+ **********************************************************************/
+
+// Test_Find
+func Test_Find(t *testing.T) {
+	r := newTree(100)
+	for _, key := range []int{-1, 0, 42, 99, 100} {
+		k, v, found := r.Find(func(n FinderNode[int, int]) finder.Action {
+			switch c := n.Cmp(key, n.Key()); {
+			case c < 0:
+				return finder.LEFT
+			case c > 0:
+				return finder.RIGHT
+			}
+			if n.Value() != n.Key()*10 {
+				t.Fatalf("node has value %d instead of %d", n.Value(), n.Key()*10)
+			}
+			return finder.FOUND
+		})
+		if found != (0 <= key && key < 100) {
+			t.Fatalf("Find(%d) returned found = %v", key, found)
+		}
+		if found && (k != key || v != key*10) {
+			t.Fatalf("Find(%d) returned (%d, %d) instead of (%d, %d)", key, k, v, key, key*10)
+		}
+	}
+}
+
+// Test_Find_NotFound confirms that a nil key is returned as the zero value
+func Test_Find_NotFound(t *testing.T) {
+	r := NewOrdered[string, string]()
+	k, v, found := r.Find(func(n FinderNode[string, string]) finder.Action {
+		t.Fatalf("Find() called f on an empty tree")
+		return finder.NOT_FOUND
+	})
+	if k != "" || v != "" || found {
+		t.Fatalf("Find() returned (%q, %q, %v) on an empty tree", k, v, found)
+	}
+}
+
+// Test_Finder_Helpers
+func Test_Finder_Helpers(t *testing.T) {
+	r := NewOrdered[int, int]()
+	for _, k := range random.Perm(100) {
+		r.ReplaceOrInsert(k*2, k) // Even keys only
+	}
+	if k, v, found := r.Min(); k != 0 || v != 0 || !found {
+		t.Fatalf("Min() returned (%d, %d, %v)", k, v, found)
+	}
+	if k, v, found := r.Max(); k != 198 || v != 99 || !found {
+		t.Fatalf("Max() returned (%d, %d, %v)", k, v, found)
+	}
+	for key := -1; key <= 200; key++ {
+		k, v, found := r.LowerBound(key)
+		k_, v_, found_ := finder.LowerBound(r.Unwrap(), key)
+		if found != found_ || (found && (k != k_ || v != v_)) {
+			t.Fatalf("LowerBound(%d) returned (%d, %d, %v) instead of (%v, %v, %v)", key, k, v, found, k_, v_, found_)
+		}
+		k, v, found = r.UpperBound(key)
+		k_, v_, found_ = finder.UpperBound(r.Unwrap(), key)
+		if found != found_ || (found && (k != k_ || v != v_)) {
+			t.Fatalf("UpperBound(%d) returned (%d, %d, %v) instead of (%v, %v, %v)", key, k, v, found, k_, v_, found_)
+		}
+	}
+}
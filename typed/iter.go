@@ -0,0 +1,46 @@
+package typed
+
+import (
+	"iter"
+)
+
+import (
+	"github.com/iNamik/go_bst/walker"
+)
+
+/**********************************************************************
+ ** Tree Methods
+ **********************************************************************/
+
+// Tree::All (see walker.All)
+func (t *Tree[K, V]) All() iter.Seq2[K, V] {
+	return seq[K, V](walker.All(t.i))
+}
+
+// Tree::Backward (see walker.Backward)
+func (t *Tree[K, V]) Backward() iter.Seq2[K, V] {
+	return seq[K, V](walker.Backward(t.i))
+}
+
+// Tree::Range (see walker.Range)
+func (t *Tree[K, V]) Range(lo K, hi K, inclusiveLo bool, inclusiveHi bool) iter.Seq2[K, V] {
+	return seq[K, V](walker.Range(t.i, lo, hi, inclusiveLo, inclusiveHi))
+}
+
+// Tree::RangeBackward (see walker.RangeBackward)
+func (t *Tree[K, V]) RangeBackward(lo K, hi K, inclusiveLo bool, inclusiveHi bool) iter.Seq2[K, V] {
+	return seq[K, V](walker.RangeBackward(t.i, lo, hi, inclusiveLo, inclusiveHi))
+}
+
+/**********************************************************************
+ ** Private Functions
+ **********************************************************************/
+
+// seq adapts an interface{} based iterator into a typed iterator
+func seq[K, V any](s iter.Seq2[interface{}, interface{}]) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		s(func(key interface{}, value interface{}) bool {
+			return yield(as[K](key), as[V](value))
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package typed
+
+import (
+	"cmp"
+)
+
+import (
+	"github.com/iNamik/go_bst"
+	"github.com/iNamik/go_bst/finder"
+	"github.com/iNamik/go_bst/simple"
+	"github.com/iNamik/go_bst/visitor"
+	"github.com/iNamik/go_bst/walker"
+	gocmp "github.com/iNamik/go_cmp"
+)
+
+/**********************************************************************
+ ** Types & Interfaces
+ **********************************************************************/
+
+// I specifies the interface{} based tree that a Tree wraps.
+// Every implementation in the go_bst sub-packages satisfies I.
+type I interface {
+	bst.T
+	finder.I
+	visitor.I
+	walker.I
+}
+
+// Tree wraps an interface{} based tree, exposing typed keys and values
+type Tree[K, V any] struct {
+	i I
+}
+
+/**********************************************************************
+ ** Public Functions
+ **********************************************************************/
+
+// New returns a Tree backed by a simple.T, ordered by fcmp
+func New[K, V any](fcmp func(a, b K) int) *Tree[K, V] {
+	return Wrap[K, V](simple.New(Cmp(fcmp)))
+}
+
+// NewOrdered returns a Tree backed by a simple.T, ordered by cmp.Compare
+func NewOrdered[K cmp.Ordered, V any]() *Tree[K, V] {
+	return New[K, V](cmp.Compare[K])
+}
+
+// Wrap returns a Tree backed by i.
+// All of the keys stored in i must be of type K, and all of the
+// values must be of type V (or nil).
+func Wrap[K, V any](i I) *Tree[K, V] {
+	return &Tree[K, V]{i: i}
+}
+
+// Cmp adapts a typed comparison function into a cmp.F, suitable for
+// passing to the New function of any go_bst implementation.
+// fcmp may return any negative or positive number, as per cmp.Compare,
+// and the result is mapped onto cmp.LT and cmp.GT.
+func Cmp[K any](fcmp func(a, b K) int) gocmp.F {
+	return func(a interface{}, b interface{}) int {
+		switch c := fcmp(a.(K), b.(K)); {
+		case c < 0:
+			return gocmp.LT
+		case c > 0:
+			return gocmp.GT
+		}
+		return 0
+	}
+}
+
+// Tree::Unwrap returns the interface{} based tree backing t
+func (t *Tree[K, V]) Unwrap() I {
+	return t.i
+}
+
+// Tree::Empty
+func (t *Tree[K, V]) Empty() bool {
+	return t.i.Empty()
+}
+
+// Tree::Size returns the number of keys in the tree.
+// If the backing tree does not implement bst.I_Size,
+// the keys are counted by walking the tree.
+func (t *Tree[K, V]) Size() int {
+	if s, ok := t.i.(bst.I_Size); ok {
+		return s.Size()
+	}
+	size := 0
+	walker.ForeachMin(t.i, func(_ interface{}, _ interface{}) {
+		size++
+	})
+	return size
+}
+
+// Tree::ReplaceOrInsert
+func (t *Tree[K, V]) ReplaceOrInsert(key K, value V) bool {
+	return t.i.ReplaceOrInsert(key, value)
+}
+
+// Tree::Get
+func (t *Tree[K, V]) Get(key K) (V, bool) {
+	value, found := t.i.Get(key)
+	return as[V](value), found
+}
+
+// Tree::Remove
+func (t *Tree[K, V]) Remove(key K) bool {
+	return t.i.Remove(key)
+}
+
+/**********************************************************************
+ ** Private Functions
+ **********************************************************************/
+
+// as converts x to T, with nil converting to the zero value of T
+func as[T any](x interface{}) T {
+	if x == nil {
+		var zero T
+		return zero
+	}
+	return x.(T)
+}
@@ -0,0 +1,146 @@
+package typed
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+import (
+	"github.com/iNamik/go_bst"
+	"github.com/iNamik/go_bst/avl"
+)
+
+/**********************************************************************
+ ** Test Data
+ **********************************************************************/
+
+// SEED keeps the tests repeatable
+const SEED = 1
+
+// random
+var random = rand.New(rand.NewSource(SEED))
+
+/**********************************************************************
+ ** Helper Types
+ **********************************************************************/
+
+// unsized hides the Size method of the tree it wraps
+type unsized struct{ I }
+
+/**********************************************************************
+ ** Helper Functions
+ **********************************************************************/
+
+// newTree returns a Tree with keys 0..n-1 inserted in random order, with value = key * 10
+func newTree(n int) *Tree[int, int] {
+	t := NewOrdered[int, int]()
+	for _, k := range random.Perm(n) {
+		t.ReplaceOrInsert(k, k*10)
+	}
+	return t
+}
+
+/**********************************************************************
+ ** Test Functions
+ **********************************************************************/
+
+// Test_New
+func Test_New(t *testing.T) {
+	r := New[string, int](strings.Compare)
+	if !r.Empty() {
+		t.Fatalf("Empty() returned false for a new tree")
+	}
+	for i, k := range []string{"b", "c", "a"} {
+		if r.ReplaceOrInsert(k, i) {
+			t.Fatalf("ReplaceOrInsert(%q) returned true for a new key", k)
+		}
+	}
+	if !r.ReplaceOrInsert("a", 3) {
+		t.Fatalf("ReplaceOrInsert(%q) returned false for an existing key", "a")
+	}
+	if size := r.Size(); size != 3 {
+		t.Fatalf("Size() returned %d instead of %d", size, 3)
+	}
+	if v, found := r.Get("a"); !found || v != 3 {
+		t.Fatalf("Get(%q) returned (%d, %v) instead of (%d, %v)", "a", v, found, 3, true)
+	}
+	if v, found := r.Get("d"); found || v != 0 {
+		t.Fatalf("Get(%q) returned (%d, %v) instead of (%d, %v)", "d", v, found, 0, false)
+	}
+	if !r.Remove("b") || r.Remove("b") {
+		t.Fatalf("Remove(%q) did not remove the key exactly once", "b")
+	}
+	if size := r.Size(); size != 2 {
+		t.Fatalf("Size() returned %d instead of %d", size, 2)
+	}
+}
+
+// Test_NewOrdered
+func Test_NewOrdered(t *testing.T) {
+	const SIZE = 1000
+	r := newTree(SIZE)
+	if size := r.Size(); size != SIZE {
+		t.Fatalf("Size() returned %d instead of %d", size, SIZE)
+	}
+	for k := -1; k <= SIZE; k++ {
+		v, found := r.Get(k)
+		if found != (0 <= k && k < SIZE) || (found && v != k*10) {
+			t.Fatalf("Get(%d) returned (%d, %v)", k, v, found)
+		}
+	}
+}
+
+// Test_Wrap
+func Test_Wrap(t *testing.T) {
+	i := avl.New(Cmp(strings.Compare))
+	r := Wrap[string, string](i)
+	if r.Unwrap() != I(i) {
+		t.Fatalf("Unwrap() did not return the wrapped tree")
+	}
+	r.ReplaceOrInsert("key", "value")
+	if v, found := i.Get("key"); !found || v != "value" {
+		t.Fatalf("wrapped tree returned (%v, %v) instead of (%v, %v)", v, found, "value", true)
+	}
+}
+
+// Test_Cmp confirms that Cmp maps any negative / positive result onto cmp.LT / cmp.GT,
+// which the implementations rely on
+func Test_Cmp(t *testing.T) {
+	fcmp := Cmp(func(a, b int) int { return (a - b) * 7 })
+	for _, test := range []struct{ a, b, result int }{{1, 5, -1}, {5, 1, 1}, {3, 3, 0}} {
+		if result := fcmp(test.a, test.b); result != test.result {
+			t.Fatalf("Cmp(%d, %d) returned %d instead of %d", test.a, test.b, result, test.result)
+		}
+	}
+	r := New[int, int](func(a, b int) int { return (a - b) * 7 })
+	for _, k := range random.Perm(100) {
+		r.ReplaceOrInsert(k, k)
+	}
+	if err := r.Unwrap().(bst.I_Validate).Validate(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// Test_Size_Unsized confirms that Size counts the keys when the backing tree does not implement bst.I_Size
+func Test_Size_Unsized(t *testing.T) {
+	const SIZE = 100
+	r := Wrap[int, int](unsized{newTree(SIZE).Unwrap()})
+	if size := r.Size(); size != SIZE {
+		t.Fatalf("Size() returned %d instead of %d", size, SIZE)
+	}
+}
+
+// Test_NilValue confirms that nil values are returned as the zero value
+func Test_NilValue(t *testing.T) {
+	r := NewOrdered[int, *int]()
+	r.ReplaceOrInsert(1, nil)
+	if v, found := r.Get(1); !found || v != nil {
+		t.Fatalf("Get(1) returned (%v, %v) instead of (%v, %v)", v, found, nil, true)
+	}
+	r.Unwrap().ReplaceOrInsert(2, nil)
+	k, v, found := r.Max()
+	if !found || k != 2 || v != nil {
+		t.Fatalf("Max() returned (%v, %v, %v) instead of (%v, %v, %v)", k, v, found, 2, nil, true)
+	}
+}
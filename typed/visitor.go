@@ -0,0 +1,47 @@
+package typed
+
+import (
+	"github.com/iNamik/go_bst/visitor"
+)
+
+/**********************************************************************
+ ** Types
+ **********************************************************************/
+
+// F_Visit defines the typed call-back function used for the Visit method
+type F_Visit[V any] func(value V, found bool) (newValue V, action visitor.Action)
+
+/**********************************************************************
+ ** Tree Methods
+ **********************************************************************/
+
+// Tree::Visit (see visitor.I)
+func (t *Tree[K, V]) Visit(key K, f F_Visit[V]) (value V, result visitor.Result) {
+	value_, result := t.i.Visit(key, func(value interface{}, found bool) (interface{}, visitor.Action) {
+		return f(as[V](value), found)
+	})
+	return as[V](value_), result
+}
+
+// Tree::GetOrInsert (see visitor.I_GetOrInsert)
+func (t *Tree[K, V]) GetOrInsert(key K, newValue V) (value V, found bool) {
+	value_, found := visitor.GetOrInsert(t.i, key, newValue)
+	return as[V](value_), found
+}
+
+// Tree::GetAndReplace (see visitor.I_GetAndReplace)
+func (t *Tree[K, V]) GetAndReplace(key K, newValue V) (oldValue V, found bool) {
+	value_, found := visitor.GetAndReplace(t.i, key, newValue)
+	return as[V](value_), found
+}
+
+// Tree::GetAndRemove (see visitor.I_GetAndRemove)
+func (t *Tree[K, V]) GetAndRemove(key K) (value V, found bool) {
+	value_, found := visitor.GetAndRemove(t.i, key)
+	return as[V](value_), found
+}
+
+// Tree::Replace (see visitor.I_Replace)
+func (t *Tree[K, V]) Replace(key K, newValue V) (replaced bool) {
+	return visitor.Replace(t.i, key, newValue)
+}
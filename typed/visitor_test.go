@@ -0,0 +1,77 @@
+package typed
+
+import (
+	"testing"
+)
+
+import (
+	"github.com/iNamik/go_bst/visitor"
+)
+
+/**********************************************************************
+ ** Test Functions
+ **********************************************************************/
+
+// Test_Visit
+func Test_Visit(t *testing.T) {
+	r := NewOrdered[string, int]()
+	// increment counts a key, starting at 1
+	increment := func(value int, found bool) (int, visitor.Action) {
+		if found {
+			return value + 1, visitor.REPLACE
+		}
+		return 1, visitor.INSERT
+	}
+	for _, k := range []string{"a", "b", "a", "c", "a", "b"} {
+		r.Visit(k, increment)
+	}
+	for k, count := range map[string]int{"a": 3, "b": 2, "c": 1} {
+		if v, found := r.Get(k); !found || v != count {
+			t.Fatalf("Get(%q) returned (%d, %v) instead of (%d, %v)", k, v, found, count, true)
+		}
+	}
+	value, result := r.Visit("b", func(value int, found bool) (int, visitor.Action) {
+		return 0, visitor.REMOVE
+	})
+	if value != 2 || result != visitor.REMOVED {
+		t.Fatalf("Visit(%q) returned (%d, %s) instead of (%d, %s)", "b", value, result, 2, visitor.REMOVED)
+	}
+	value, result = r.Visit("b", func(value int, found bool) (int, visitor.Action) {
+		if found || value != 0 {
+			t.Fatalf("Visit(%q) called f with (%d, %v) instead of (%d, %v)", "b", value, found, 0, false)
+		}
+		return 0, visitor.GET
+	})
+	if value != 0 || result != visitor.NOT_FOUND {
+		t.Fatalf("Visit(%q) returned (%d, %s) instead of (%d, %s)", "b", value, result, 0, visitor.NOT_FOUND)
+	}
+}
+
+// Test_Visitor_Helpers
+func Test_Visitor_Helpers(t *testing.T) {
+	r := NewOrdered[int, string]()
+	if v, found := r.GetOrInsert(1, "one"); v != "one" || found {
+		t.Fatalf("GetOrInsert(1) returned (%q, %v)", v, found)
+	}
+	if v, found := r.GetOrInsert(1, "uno"); v != "one" || !found {
+		t.Fatalf("GetOrInsert(1) returned (%q, %v)", v, found)
+	}
+	if v, found := r.GetAndReplace(1, "uno"); v != "one" || !found {
+		t.Fatalf("GetAndReplace(1) returned (%q, %v)", v, found)
+	}
+	if v, found := r.GetAndReplace(2, "dos"); v != "" || found {
+		t.Fatalf("GetAndReplace(2) returned (%q, %v)", v, found)
+	}
+	if replaced := r.Replace(2, "dos"); replaced {
+		t.Fatalf("Replace(2) returned %v", replaced)
+	}
+	if replaced := r.Replace(1, "one"); !replaced {
+		t.Fatalf("Replace(1) returned %v", replaced)
+	}
+	if v, found := r.GetAndRemove(1); v != "one" || !found {
+		t.Fatalf("GetAndRemove(1) returned (%q, %v)", v, found)
+	}
+	if !r.Empty() {
+		t.Fatalf("Empty() returned false after removing every key")
+	}
+}
@@ -0,0 +1,127 @@
+package typed
+
+import (
+	"github.com/iNamik/go_bst/walker"
+)
+
+/**********************************************************************
+ ** Types
+ **********************************************************************/
+
+// F_Walk defines the typed call-back function used for the Walk method
+type F_Walk[K, V any] func(WalkerNode[K, V]) walker.Action
+
+// F_Foreach defines the typed function for visiting nodes in sequential order
+type F_Foreach[K, V any] func(key K, value V)
+
+// F_ForeachWhile defines the typed function for visiting nodes in
+// sequential order that can stop the iteration by returning false
+type F_ForeachWhile[K, V any] func(key K, value V) (next bool)
+
+// WalkerNode is a typed view of walker.Node
+type WalkerNode[K, V any] interface {
+	FinderNode[K, V] // extends FinderNode
+	Level() int
+	HasParent() bool
+	HasPrev() bool
+	HasNext() bool
+}
+
+// walkerNode
+type walkerNode[K, V any] struct {
+	finderNode[K, V]
+	n walker.Node
+}
+
+/**********************************************************************
+ ** Tree Methods
+ **********************************************************************/
+
+// Tree::Walk (see walker.I)
+func (t *Tree[K, V]) Walk(f F_Walk[K, V]) {
+	t.i.Walk(func(n walker.Node) walker.Action {
+		return f(walkerNode[K, V]{finderNode[K, V]{n}, n})
+	})
+}
+
+// Tree::ForeachMin (see walker.I_ForeachMin)
+func (t *Tree[K, V]) ForeachMin(f F_Foreach[K, V]) {
+	walker.ForeachMin(t.i, foreach(f))
+}
+
+// Tree::ForeachMax (see walker.I_ForeachMax)
+func (t *Tree[K, V]) ForeachMax(f F_Foreach[K, V]) {
+	walker.ForeachMax(t.i, foreach(f))
+}
+
+// Tree::ForeachRange (see walker.I_ForeachRange)
+func (t *Tree[K, V]) ForeachRange(lo K, hi K, inclusiveLo bool, inclusiveHi bool, f F_Foreach[K, V]) {
+	walker.ForeachRange(t.i, lo, hi, inclusiveLo, inclusiveHi, foreach(f))
+}
+
+// Tree::ForeachRangeReverse (see walker.I_ForeachRangeReverse)
+func (t *Tree[K, V]) ForeachRangeReverse(lo K, hi K, inclusiveLo bool, inclusiveHi bool, f F_Foreach[K, V]) {
+	walker.ForeachRangeReverse(t.i, lo, hi, inclusiveLo, inclusiveHi, foreach(f))
+}
+
+// Tree::ForeachMinWhile (see walker.I_ForeachMinWhile)
+func (t *Tree[K, V]) ForeachMinWhile(f F_ForeachWhile[K, V]) {
+	walker.ForeachMinWhile(t.i, foreachWhile(f))
+}
+
+// Tree::ForeachMaxWhile (see walker.I_ForeachMaxWhile)
+func (t *Tree[K, V]) ForeachMaxWhile(f F_ForeachWhile[K, V]) {
+	walker.ForeachMaxWhile(t.i, foreachWhile(f))
+}
+
+// Tree::ForeachRangeWhile (see walker.I_ForeachRangeWhile)
+func (t *Tree[K, V]) ForeachRangeWhile(lo K, hi K, inclusiveLo bool, inclusiveHi bool, f F_ForeachWhile[K, V]) {
+	walker.ForeachRangeWhile(t.i, lo, hi, inclusiveLo, inclusiveHi, foreachWhile(f))
+}
+
+// Tree::ForeachRangeReverseWhile (see walker.I_ForeachRangeReverseWhile)
+func (t *Tree[K, V]) ForeachRangeReverseWhile(lo K, hi K, inclusiveLo bool, inclusiveHi bool, f F_ForeachWhile[K, V]) {
+	walker.ForeachRangeReverseWhile(t.i, lo, hi, inclusiveLo, inclusiveHi, foreachWhile(f))
+}
+
+/**********************************************************************
+ ** WalkerNode
+ **********************************************************************/
+
+// walkerNode::Level
+func (n walkerNode[K, V]) Level() int {
+	return n.n.Level()
+}
+
+// walkerNode::HasParent
+func (n walkerNode[K, V]) HasParent() bool {
+	return n.n.HasParent()
+}
+
+// walkerNode::HasPrev
+func (n walkerNode[K, V]) HasPrev() bool {
+	return n.n.HasPrev()
+}
+
+// walkerNode::HasNext
+func (n walkerNode[K, V]) HasNext() bool {
+	return n.n.HasNext()
+}
+
+/**********************************************************************
+ ** Private Functions
+ **********************************************************************/
+
+// foreach adapts a typed F_Foreach into a walker.F_Visit
+func foreach[K, V any](f F_Foreach[K, V]) walker.F_Visit {
+	return func(key interface{}, value interface{}) {
+		f(as[K](key), as[V](value))
+	}
+}
+
+// foreachWhile adapts a typed F_ForeachWhile into a walker.F_VisitWhile
+func foreachWhile[K, V any](f F_ForeachWhile[K, V]) walker.F_VisitWhile {
+	return func(key interface{}, value interface{}) bool {
+		return f(as[K](key), as[V](value))
+	}
+}
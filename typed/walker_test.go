@@ -0,0 +1,128 @@
+package typed
+
+import (
+	"testing"
+)
+
+import (
+	"github.com/iNamik/go_bst/walker"
+)
+
+/**********************************************************************
+ ** Test Functions
+ **********************************************************************/
+
+// Test_Walk walks the tree in order using LEFT, NEXT and the typed node
+func Test_Walk(t *testing.T) {
+	const SIZE = 100
+	r := newTree(SIZE)
+	i := 0
+	r.Walk(func(n WalkerNode[int, int]) walker.Action {
+		if n.HasLeft() && i == 0 {
+			return walker.LEFT
+		}
+		if n.Key() != i || n.Value() != i*10 {
+			t.Fatalf("walked (%d, %d) instead of (%d, %d)", n.Key(), n.Value(), i, i*10)
+		}
+		if n.HasPrev() != (i > 0) || n.HasNext() != (i < SIZE-1) {
+			t.Fatalf("node %d has HasPrev() = %v, HasNext() = %v", i, n.HasPrev(), n.HasNext())
+		}
+		if n.Level() < 1 || n.HasParent() != (n.Level() > 1) {
+			t.Fatalf("node %d has Level() = %d, HasParent() = %v", i, n.Level(), n.HasParent())
+		}
+		i++
+		if n.HasNext() {
+			return walker.NEXT
+		}
+		return walker.RETURN
+	})
+	if i != SIZE {
+		t.Fatalf("walked %d keys instead of %d", i, SIZE)
+	}
+}
+
+// Test_Walker_Helpers
+func Test_Walker_Helpers(t *testing.T) {
+	const SIZE = 100
+	r := newTree(SIZE)
+	var keys []int
+	// collect appends each key visited to keys
+	collect := func(k int, v int) {
+		if v != k*10 {
+			t.Fatalf("visited (%d, %d) instead of (%d, %d)", k, v, k, k*10)
+		}
+		keys = append(keys, k)
+	}
+	// stopAt returns a F_ForeachWhile that collects keys up to and including key
+	stopAt := func(key int) F_ForeachWhile[int, int] {
+		return func(k int, v int) bool {
+			collect(k, v)
+			return k != key
+		}
+	}
+	for _, test := range []struct {
+		name     string
+		foreach  func()
+		expected []int
+	}{
+		{"ForeachMin", func() { r.ForeachMin(collect) }, sequence(0, SIZE-1)},
+		{"ForeachMax", func() { r.ForeachMax(collect) }, sequence(SIZE-1, 0)},
+		{"ForeachRange", func() { r.ForeachRange(10, 20, false, true, collect) }, sequence(11, 20)},
+		{"ForeachRangeReverse", func() { r.ForeachRangeReverse(10, 20, true, false, collect) }, sequence(19, 10)},
+		{"ForeachMinWhile", func() { r.ForeachMinWhile(stopAt(5)) }, sequence(0, 5)},
+		{"ForeachMaxWhile", func() { r.ForeachMaxWhile(stopAt(95)) }, sequence(SIZE-1, 95)},
+		{"ForeachRangeWhile", func() { r.ForeachRangeWhile(10, 20, true, true, stopAt(15)) }, sequence(10, 15)},
+		{"ForeachRangeReverseWhile", func() { r.ForeachRangeReverseWhile(10, 20, true, true, stopAt(15)) }, sequence(20, 15)},
+		{"All", func() {
+			for k, v := range r.All() {
+				collect(k, v)
+			}
+		}, sequence(0, SIZE-1)},
+		{"Backward", func() {
+			for k, v := range r.Backward() {
+				if collect(k, v); k == 95 {
+					break
+				}
+			}
+		}, sequence(SIZE-1, 95)},
+		{"Range", func() {
+			for k, v := range r.Range(10, 20, false, true) {
+				if collect(k, v); k == 15 {
+					break
+				}
+			}
+		}, sequence(11, 15)},
+		{"RangeBackward", func() {
+			for k, v := range r.RangeBackward(10, 20, true, false) {
+				collect(k, v)
+			}
+		}, sequence(19, 10)},
+	} {
+		keys = keys[:0]
+		test.foreach()
+		if len(keys) != len(test.expected) {
+			t.Fatalf("%s() visited %v instead of %v", test.name, keys, test.expected)
+		}
+		for i := range keys {
+			if keys[i] != test.expected[i] {
+				t.Fatalf("%s() visited %v instead of %v", test.name, keys, test.expected)
+			}
+		}
+	}
+}
+
+// sequence returns the keys from..to inclusive, counting down if from > to
+func sequence(from int, to int) []int {
+	var keys []int
+	for k := from; ; {
+		keys = append(keys, k)
+		if k == to {
+			return keys
+		}
+		if from < to {
+			k++
+		} else {
+			k--
+		}
+	}
+}
@@ -0,0 +1,205 @@
+package visitor
+
+import (
+	"github.com/iNamik/go_bst"
+)
+
+/**********************************************************************
+ ** VisitE
+ **********************************************************************/
+
+// I_VisitE
+type I_VisitE interface {
+	// VisitE is Visit, but reports an illegal action, or a panic in f,
+	// as an error instead of panicking.  The tree is left unchanged.
+	VisitE(key interface{}, f F) (value interface{}, result Result, err error)
+}
+
+// t::VisitE
+func (v *t) VisitE(key interface{}, f F) (interface{}, Result, error) {
+	return VisitE(v.i, key, f)
+}
+
+// VisitE checks the action returned by f before passing it on to the
+// tree, and recovers panics in f, so works with any implementation of I.
+// Errors are reported as *bst.ActionError or *bst.PanicError.
+func VisitE(v I, key interface{}, f F) (value interface{}, result Result, err error) {
+	value, result = v.Visit(key, func(value interface{}, found bool) (newValue interface{}, action Action) {
+		defer func() {
+			if r := recover(); r != nil {
+				err, newValue, action = &bst.PanicError{Op: "visit", Value: r}, nil, GET
+			}
+		}()
+		switch newValue, action = f(value, found); {
+		case action == GET:
+			return newValue, action
+		case found && (action == REPLACE || action == REMOVE):
+			return newValue, action
+		case !found && action == INSERT:
+			return newValue, action
+		}
+		err = &bst.ActionError{Op: "visit", Action: action, Err: bst.ErrIllegalAction}
+		return nil, GET
+	})
+	if err != nil {
+		return nil, NOT_FOUND, err
+	}
+	return value, result, nil
+}
+
+/**********************************************************************
+ ** HelpersE
+ **********************************************************************/
+
+// I_HelpersE contains the error-returning versions of the helper methods,
+// which report any error from VisitE instead of panicking
+type I_HelpersE interface {
+	GetE(key interface{}) (value interface{}, found bool, err error)
+	GetOrInsertE(key interface{}, newValue interface{}) (value interface{}, found bool, err error)
+	GetAndReplaceE(key interface{}, newValue interface{}) (oldValue interface{}, found bool, err error)
+	GetAndReplaceOrInsertE(key interface{}, newValue interface{}) (value interface{}, found bool, err error)
+	GetAndRemoveE(key interface{}) (value interface{}, found bool, err error)
+	GetAndRemoveOrInsertE(key interface{}, newValue interface{}) (value interface{}, found bool, err error)
+	ReplaceE(key interface{}, newValue interface{}) (replaced bool, err error)
+	ReplaceOrInsertE(key interface{}, newValue interface{}) (replaced bool, err error)
+	RemoveE(key interface{}) (removed bool, err error)
+	RemoveOrInsertE(key interface{}, newValue interface{}) (removed bool, err error)
+}
+
+// t::GetE
+func (v *t) GetE(key interface{}) (interface{}, bool, error) {
+	return GetE(v.i, key)
+}
+
+// t::GetOrInsertE
+func (v *t) GetOrInsertE(key interface{}, newValue interface{}) (interface{}, bool, error) {
+	return GetOrInsertE(v.i, key, newValue)
+}
+
+// t::GetAndReplaceE
+func (v *t) GetAndReplaceE(key interface{}, newValue interface{}) (interface{}, bool, error) {
+	return GetAndReplaceE(v.i, key, newValue)
+}
+
+// t::GetAndReplaceOrInsertE
+func (v *t) GetAndReplaceOrInsertE(key interface{}, newValue interface{}) (interface{}, bool, error) {
+	return GetAndReplaceOrInsertE(v.i, key, newValue)
+}
+
+// t::GetAndRemoveE
+func (v *t) GetAndRemoveE(key interface{}) (interface{}, bool, error) {
+	return GetAndRemoveE(v.i, key)
+}
+
+// t::GetAndRemoveOrInsertE
+func (v *t) GetAndRemoveOrInsertE(key interface{}, newValue interface{}) (interface{}, bool, error) {
+	return GetAndRemoveOrInsertE(v.i, key, newValue)
+}
+
+// t::ReplaceE
+func (v *t) ReplaceE(key interface{}, newValue interface{}) (bool, error) {
+	return ReplaceE(v.i, key, newValue)
+}
+
+// t::ReplaceOrInsertE
+func (v *t) ReplaceOrInsertE(key interface{}, newValue interface{}) (bool, error) {
+	return ReplaceOrInsertE(v.i, key, newValue)
+}
+
+// t::RemoveE
+func (v *t) RemoveE(key interface{}) (bool, error) {
+	return RemoveE(v.i, key)
+}
+
+// t::RemoveOrInsertE
+func (v *t) RemoveOrInsertE(key interface{}, newValue interface{}) (bool, error) {
+	return RemoveOrInsertE(v.i, key, newValue)
+}
+
+// GetE (see Get)
+func GetE(v I, key interface{}) (value interface{}, found bool, err error) {
+	if value, found = get(visitE(v, &err), key); err != nil {
+		return nil, false, err
+	}
+	return value, found, nil
+}
+
+// GetOrInsertE (see GetOrInsert)
+func GetOrInsertE(v I, key interface{}, newValue interface{}) (value interface{}, found bool, err error) {
+	if value, found = getOrInsert(visitE(v, &err), key, newValue); err != nil {
+		return nil, false, err
+	}
+	return value, found, nil
+}
+
+// GetAndReplaceE (see GetAndReplace)
+func GetAndReplaceE(v I, key interface{}, newValue interface{}) (value interface{}, found bool, err error) {
+	if value, found = getAndReplace(visitE(v, &err), key, newValue); err != nil {
+		return nil, false, err
+	}
+	return value, found, nil
+}
+
+// GetAndReplaceOrInsertE (see GetAndReplaceOrInsert)
+func GetAndReplaceOrInsertE(v I, key interface{}, newValue interface{}) (value interface{}, found bool, err error) {
+	if value, found = getAndReplaceOrInsert(visitE(v, &err), key, newValue); err != nil {
+		return nil, false, err
+	}
+	return value, found, nil
+}
+
+// GetAndRemoveE (see GetAndRemove)
+func GetAndRemoveE(v I, key interface{}) (value interface{}, found bool, err error) {
+	if value, found = getAndRemove(visitE(v, &err), key); err != nil {
+		return nil, false, err
+	}
+	return value, found, nil
+}
+
+// GetAndRemoveOrInsertE (see GetAndRemoveOrInsert)
+func GetAndRemoveOrInsertE(v I, key interface{}, newValue interface{}) (value interface{}, found bool, err error) {
+	if value, found = getAndRemoveOrInsert(visitE(v, &err), key, newValue); err != nil {
+		return nil, false, err
+	}
+	return value, found, nil
+}
+
+// ReplaceE (see Replace)
+func ReplaceE(v I, key interface{}, newValue interface{}) (ok bool, err error) {
+	if ok = replace(visitE(v, &err), key, newValue); err != nil {
+		return false, err
+	}
+	return ok, nil
+}
+
+// ReplaceOrInsertE (see ReplaceOrInsert)
+func ReplaceOrInsertE(v I, key interface{}, newValue interface{}) (ok bool, err error) {
+	if ok = replaceOrInsert(visitE(v, &err), key, newValue); err != nil {
+		return false, err
+	}
+	return ok, nil
+}
+
+// RemoveE (see Remove)
+func RemoveE(v I, key interface{}) (ok bool, err error) {
+	if ok = remove(visitE(v, &err), key); err != nil {
+		return false, err
+	}
+	return ok, nil
+}
+
+// RemoveOrInsertE (see RemoveOrInsert)
+func RemoveOrInsertE(v I, key interface{}, newValue interface{}) (ok bool, err error) {
+	if ok = removeOrInsert(visitE(v, &err), key, newValue); err != nil {
+		return false, err
+	}
+	return ok, nil
+}
+
+// visitE adapts VisitE to the signature of I.Visit, storing any error in err
+func visitE(v I, err *error) visitFunc {
+	return func(key interface{}, f F) (value interface{}, result Result) {
+		value, result, *err = VisitE(v, key, f)
+		return
+	}
+}
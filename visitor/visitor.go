@@ -51,6 +51,23 @@ for Get/Insert/Replace/Remove.  Although some may not seem very
 useful, they are included for completeness.
 
 
+Errors
+------
+
+Implementations typically panic when the Visit call-back returns
+an action that is not valid for a found (GET, REPLACE, REMOVE) or
+non-found (GET, INSERT) key.  VisitE reports these as a
+*bst.ActionError (wrapping bst.ErrIllegalAction), and a panic in
+the call-back as a *bst.PanicError, instead of panicking.  The
+tree is left unchanged.
+
+VisitE checks each action before passing it on to the tree, so it
+works with any implementation of Visit.
+
+Each helper has an error-returning version (GetE, GetOrInsertE, etc.),
+built on VisitE.
+
+
 Efficiency
 ----------
 
@@ -100,6 +117,8 @@ type T interface {
 	I_ReplaceOrInsert
 	I_Remove
 	I_RemoveOrInsert
+	I_VisitE
+	I_HelpersE
 }
 
 // I defines the extensible visitor interface
@@ -110,6 +129,9 @@ type I interface {
 // F defines the call-back function used for the Visit method
 type F func(value interface{}, found bool) (newValue interface{}, action Action)
 
+// visitFunc is the signature of I.Visit, which the helper functions are built on
+type visitFunc func(key interface{}, f F) (value interface{}, result Result)
+
 // t
 type t struct{ i I }
 
@@ -204,7 +226,12 @@ func (v *t) Get(key interface{}) (interface{}, bool) {
 
 // Get
 func Get(v I, key interface{}) (interface{}, bool) {
-	value, result := v.Visit(key, func(_ interface{}, _ bool) (interface{}, Action) {
+	return get(v.Visit, key)
+}
+
+// get implements Get using visit, which is either I.Visit or VisitE
+func get(visit visitFunc, key interface{}) (interface{}, bool) {
+	value, result := visit(key, func(_ interface{}, _ bool) (interface{}, Action) {
 		return nil, GET
 	})
 	return value, result == FOUND
@@ -226,7 +253,12 @@ func (v *t) GetOrInsert(key interface{}, newValue interface{}) (interface{}, boo
 
 // GetOrInsert
 func GetOrInsert(v I, key interface{}, newValue interface{}) (interface{}, bool) {
-	value, result := v.Visit(key, func(_ interface{}, found bool) (interface{}, Action) {
+	return getOrInsert(v.Visit, key, newValue)
+}
+
+// getOrInsert implements GetOrInsert using visit
+func getOrInsert(visit visitFunc, key interface{}, newValue interface{}) (interface{}, bool) {
+	value, result := visit(key, func(_ interface{}, found bool) (interface{}, Action) {
 		if found {
 			return nil, GET
 		}
@@ -251,7 +283,12 @@ func (v *t) GetAndReplace(key interface{}, newValue interface{}) (interface{}, b
 
 // GetAndReplace
 func GetAndReplace(v I, key interface{}, newValue interface{}) (value interface{}, _ bool) {
-	_, result := v.Visit(key, func(oldValue interface{}, found bool) (interface{}, Action) {
+	return getAndReplace(v.Visit, key, newValue)
+}
+
+// getAndReplace implements GetAndReplace using visit
+func getAndReplace(visit visitFunc, key interface{}, newValue interface{}) (value interface{}, _ bool) {
+	_, result := visit(key, func(oldValue interface{}, found bool) (interface{}, Action) {
 		if found {
 			value = oldValue
 			return newValue, REPLACE
@@ -259,7 +296,7 @@ func GetAndReplace(v I, key interface{}, newValue interface{}) (value interface{
 		value = nil
 		return nil, GET
 	})
-	return value, result == FOUND // found == replaced
+	return value, result == REPLACED // replaced == found
 }
 
 /**********************************************************************
@@ -278,7 +315,12 @@ func (v *t) GetAndReplaceOrInsert(key interface{}, newValue interface{}) (interf
 
 // GetAndReplaceOrInsert
 func GetAndReplaceOrInsert(v I, key interface{}, newValue interface{}) (value interface{}, _ bool) {
-	_, result := v.Visit(key, func(oldValue interface{}, found bool) (interface{}, Action) {
+	return getAndReplaceOrInsert(v.Visit, key, newValue)
+}
+
+// getAndReplaceOrInsert implements GetAndReplaceOrInsert using visit
+func getAndReplaceOrInsert(visit visitFunc, key interface{}, newValue interface{}) (value interface{}, _ bool) {
+	_, result := visit(key, func(oldValue interface{}, found bool) (interface{}, Action) {
 		if found {
 			value = oldValue
 			return newValue, REPLACE
@@ -305,7 +347,12 @@ func (v *t) GetAndRemove(key interface{}) (value interface{}, found bool) {
 
 // GetAndRemove
 func GetAndRemove(v I, key interface{}) (value interface{}, _ bool) {
-	_, result := v.Visit(key, func(oldValue interface{}, found bool) (interface{}, Action) {
+	return getAndRemove(v.Visit, key)
+}
+
+// getAndRemove implements GetAndRemove using visit
+func getAndRemove(visit visitFunc, key interface{}) (value interface{}, _ bool) {
+	_, result := visit(key, func(oldValue interface{}, found bool) (interface{}, Action) {
 		if found {
 			value = oldValue
 			return nil, REMOVE
@@ -332,7 +379,12 @@ func (v *t) GetAndRemoveOrInsert(key interface{}, newValue interface{}) (interfa
 
 // GetAndRemoveOrInsert
 func GetAndRemoveOrInsert(v I, key interface{}, newValue interface{}) (value interface{}, _ bool) {
-	_, result := v.Visit(key, func(oldValue interface{}, found bool) (interface{}, Action) {
+	return getAndRemoveOrInsert(v.Visit, key, newValue)
+}
+
+// getAndRemoveOrInsert implements GetAndRemoveOrInsert using visit
+func getAndRemoveOrInsert(visit visitFunc, key interface{}, newValue interface{}) (value interface{}, _ bool) {
+	_, result := visit(key, func(oldValue interface{}, found bool) (interface{}, Action) {
 		if found {
 			value = oldValue
 			return nil, REMOVE
@@ -359,7 +411,12 @@ func (v *t) Replace(key interface{}, newValue interface{}) bool {
 
 // Replace
 func Replace(v I, key interface{}, newValue interface{}) bool {
-	_, result := v.Visit(key, func(_ interface{}, found bool) (interface{}, Action) {
+	return replace(v.Visit, key, newValue)
+}
+
+// replace implements Replace using visit
+func replace(visit visitFunc, key interface{}, newValue interface{}) bool {
+	_, result := visit(key, func(_ interface{}, found bool) (interface{}, Action) {
 		if found {
 			return newValue, REPLACE
 		}
@@ -386,7 +443,12 @@ func (v *t) ReplaceOrInsert(key interface{}, newValue interface{}) bool {
 
 // ReplaceOrInsert
 func ReplaceOrInsert(v I, key interface{}, newValue interface{}) bool {
-	_, result := v.Visit(key, func(_ interface{}, found bool) (interface{}, Action) {
+	return replaceOrInsert(v.Visit, key, newValue)
+}
+
+// replaceOrInsert implements ReplaceOrInsert using visit
+func replaceOrInsert(visit visitFunc, key interface{}, newValue interface{}) bool {
+	_, result := visit(key, func(_ interface{}, found bool) (interface{}, Action) {
 		if found {
 			return newValue, REPLACE
 		}
@@ -413,7 +475,12 @@ func (v *t) Remove(key interface{}) bool {
 
 // Remove
 func Remove(v I, key interface{}) bool {
-	_, result := v.Visit(key, func(_ interface{}, found bool) (interface{}, Action) {
+	return remove(v.Visit, key)
+}
+
+// remove implements Remove using visit
+func remove(visit visitFunc, key interface{}) bool {
+	_, result := visit(key, func(_ interface{}, found bool) (interface{}, Action) {
 		if found {
 			return nil, REMOVE
 		}
@@ -438,7 +505,12 @@ func (v *t) RemoveOrInsert(key interface{}, newValue interface{}) bool {
 
 // RemoveOrInsert
 func RemoveOrInsert(v I, key interface{}, newValue interface{}) bool {
-	_, result := v.Visit(key, func(_ interface{}, found bool) (interface{}, Action) {
+	return removeOrInsert(v.Visit, key, newValue)
+}
+
+// removeOrInsert implements RemoveOrInsert using visit
+func removeOrInsert(visit visitFunc, key interface{}, newValue interface{}) bool {
+	_, result := visit(key, func(_ interface{}, found bool) (interface{}, Action) {
 		if found {
 			return nil, REMOVE
 		}
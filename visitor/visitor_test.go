@@ -4,11 +4,13 @@ package visitor_test
 //import . "github.com/iNamik/go_pkg/debug/ping"
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 )
 
 import (
+	"github.com/iNamik/go_bst"
 	"github.com/iNamik/go_bst/visitor"
 )
 
@@ -53,6 +55,17 @@ func assertResultString(result visitor.Result, value string, t *testing.T) {
 	}
 }
 
+// assertActionError confirms that err is a *bst.ActionError wrapping target
+func assertActionError(err error, target error, msg string, t *testing.T) {
+	var e *bst.ActionError
+	if errors.As(err, &e) == false || errors.Is(err, target) == false {
+		t.Fatalf("returned error '%v' instead of a *bst.ActionError wrapping '%v'", err, target)
+	}
+	if err.Error() != msg {
+		t.Fatalf("returned error '%s' instead of '%s'", err, msg)
+	}
+}
+
 /**********************************************************************
  ** Test Functions
  **********************************************************************/
@@ -144,7 +157,7 @@ func Test_GetAndReplace_Found(t *testing.T) {
 	const NEW = 1
 	w := visitor.New((f_visit)(func(key interface{}, f visitor.F) (interface{}, visitor.Result) {
 		assertVisit(f, OLD, true, NEW, visitor.REPLACE, t)
-		return NEW, visitor.FOUND
+		return NEW, visitor.REPLACED
 	}))
 	value_, found := w.GetAndReplace(OLD, NEW)
 	if found == false {
@@ -408,3 +421,115 @@ func Test_Result_String(t *testing.T) {
 
 	assertResultString(visitor.Result(-1), "visitor.Result(-1)", t)
 }
+
+// Test_VisitE
+func Test_VisitE(t *testing.T) {
+	const VALUE = 1
+	v := (f_visit)(func(key interface{}, f visitor.F) (interface{}, visitor.Result) {
+		assertVisit(f, nil, false, VALUE, visitor.INSERT, t)
+		return VALUE, visitor.INSERTED
+	})
+	value, result, err := visitor.VisitE(v, VALUE, func(_ interface{}, _ bool) (interface{}, visitor.Action) {
+		return VALUE, visitor.INSERT
+	})
+	if value != VALUE || result != visitor.INSERTED || err != nil {
+		t.Fatalf("VisitE() returned (%v, %v, %v)", value, result, err)
+	}
+}
+
+// Test_VisitE_IllegalAction
+func Test_VisitE_IllegalAction(t *testing.T) {
+	const VALUE = 1
+	for _, test := range []struct {
+		found  bool
+		action visitor.Action
+		msg    string
+	}{
+		{true, visitor.INSERT, "visit: illegal action 'INSERT'"},
+		{false, visitor.REPLACE, "visit: illegal action 'REPLACE'"},
+		{false, visitor.REMOVE, "visit: illegal action 'REMOVE'"},
+		{true, visitor.Action(-1), "visit: illegal action 'visitor.Action(-1)'"},
+	} {
+		v := (f_visit)(func(key interface{}, f visitor.F) (interface{}, visitor.Result) {
+			// The illegal action is replaced with GET, leaving the tree unchanged
+			assertVisit(f, VALUE, test.found, nil, visitor.GET, t)
+			return VALUE, visitor.FOUND
+		})
+		value, result, err := visitor.VisitE(v, VALUE, func(_ interface{}, _ bool) (interface{}, visitor.Action) {
+			return VALUE, test.action
+		})
+		if value != nil || result != visitor.NOT_FOUND {
+			t.Fatalf("VisitE() returned (%v, %v) for an illegal action", value, result)
+		}
+		assertActionError(err, bst.ErrIllegalAction, test.msg, t)
+	}
+}
+
+// Test_VisitE_Panic
+func Test_VisitE_Panic(t *testing.T) {
+	const VALUE = 1
+	v := (f_visit)(func(key interface{}, f visitor.F) (interface{}, visitor.Result) {
+		assertVisit(f, VALUE, true, nil, visitor.GET, t)
+		return VALUE, visitor.FOUND
+	})
+	_, _, err := visitor.VisitE(v, VALUE, func(_ interface{}, _ bool) (interface{}, visitor.Action) {
+		panic("oops")
+	})
+	var e *bst.PanicError
+	if errors.As(err, &e) == false || e.Value != "oops" {
+		t.Fatalf("returned error '%v' instead of a *bst.PanicError holding 'oops'", err)
+	}
+}
+
+// Test_GetOrInsertE_NotFound
+func Test_GetOrInsertE_NotFound(t *testing.T) {
+	const VALUE = 1
+	w := visitor.New((f_visit)(func(key interface{}, f visitor.F) (interface{}, visitor.Result) {
+		assertVisit(f, nil, false, VALUE, visitor.INSERT, t)
+		return VALUE, visitor.INSERTED
+	}))
+	value, found, err := w.GetOrInsertE(VALUE, VALUE)
+	if value != VALUE || found != false || err != nil {
+		t.Fatalf("GetOrInsertE() returned (%v, %v, %v)", value, found, err)
+	}
+}
+
+// Test_GetAndReplaceE_Found
+func Test_GetAndReplaceE_Found(t *testing.T) {
+	const OLD_VALUE = 1
+	const NEW_VALUE = 2
+	w := visitor.New((f_visit)(func(key interface{}, f visitor.F) (interface{}, visitor.Result) {
+		assertVisit(f, OLD_VALUE, true, NEW_VALUE, visitor.REPLACE, t)
+		return NEW_VALUE, visitor.REPLACED
+	}))
+	value, found, err := w.GetAndReplaceE(OLD_VALUE, NEW_VALUE)
+	if value != OLD_VALUE || found != true || err != nil {
+		t.Fatalf("GetAndReplaceE() returned (%v, %v, %v)", value, found, err)
+	}
+}
+
+// Test_RemoveE_Found
+func Test_RemoveE_Found(t *testing.T) {
+	const VALUE = 1
+	w := visitor.New((f_visit)(func(key interface{}, f visitor.F) (interface{}, visitor.Result) {
+		assertVisit(f, VALUE, true, nil, visitor.REMOVE, t)
+		return VALUE, visitor.REMOVED
+	}))
+	removed, err := w.RemoveE(VALUE)
+	if removed != true || err != nil {
+		t.Fatalf("RemoveE() returned (%v, %v)", removed, err)
+	}
+}
+
+// Test_ReplaceOrInsertE_NotFound
+func Test_ReplaceOrInsertE_NotFound(t *testing.T) {
+	const VALUE = 1
+	w := visitor.New((f_visit)(func(key interface{}, f visitor.F) (interface{}, visitor.Result) {
+		assertVisit(f, nil, false, VALUE, visitor.INSERT, t)
+		return VALUE, visitor.INSERTED
+	}))
+	replaced, err := w.ReplaceOrInsertE(VALUE, VALUE)
+	if replaced != false || err != nil {
+		t.Fatalf("ReplaceOrInsertE() returned (%v, %v)", replaced, err)
+	}
+}
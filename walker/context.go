@@ -0,0 +1,94 @@
+package walker
+
+import (
+	"context"
+)
+
+/**********************************************************************
+ ** WalkContext
+ **********************************************************************/
+
+// I_WalkContext
+type I_WalkContext interface {
+	// WalkContext is WalkE, but also stops the walk, returning ctx.Err(),
+	// once ctx is done
+	WalkContext(ctx context.Context, f F) error
+}
+
+// t::WalkContext
+func (w *t) WalkContext(ctx context.Context, f F) error {
+	return WalkContext(ctx, w.i, f)
+}
+
+// WalkContext checks ctx before each call to f, so a walk holds the
+// tree for at most one call-back after ctx is done.
+// Errors from f are reported as per WalkE.
+func WalkContext(ctx context.Context, w I, f F) error {
+	var ctxErr error
+	err := WalkE(w, func(n Node) Action {
+		select {
+		case <-ctx.Done():
+			ctxErr = ctx.Err()
+			return RETURN
+		default:
+		}
+		return f(n)
+	})
+	if ctxErr != nil {
+		return ctxErr
+	}
+	return err
+}
+
+/**********************************************************************
+ ** ForeachContext
+ **********************************************************************/
+
+// I_ForeachContext contains the versions of the Foreach methods that
+// stop the iteration, returning ctx.Err(), once ctx is done
+type I_ForeachContext interface {
+	ForeachMinContext(ctx context.Context, f F_Visit) error
+	ForeachMaxContext(ctx context.Context, f F_Visit) error
+	ForeachRangeContext(ctx context.Context, lo interface{}, hi interface{}, inclusiveLo bool, inclusiveHi bool, f F_Visit) error
+	ForeachRangeReverseContext(ctx context.Context, lo interface{}, hi interface{}, inclusiveLo bool, inclusiveHi bool, f F_Visit) error
+}
+
+// t::ForeachMinContext
+func (w *t) ForeachMinContext(ctx context.Context, f F_Visit) error {
+	return ForeachMinContext(ctx, w.i, f)
+}
+
+// t::ForeachMaxContext
+func (w *t) ForeachMaxContext(ctx context.Context, f F_Visit) error {
+	return ForeachMaxContext(ctx, w.i, f)
+}
+
+// t::ForeachRangeContext
+func (w *t) ForeachRangeContext(ctx context.Context, lo interface{}, hi interface{}, inclusiveLo bool, inclusiveHi bool, f F_Visit) error {
+	return ForeachRangeContext(ctx, w.i, lo, hi, inclusiveLo, inclusiveHi, f)
+}
+
+// t::ForeachRangeReverseContext
+func (w *t) ForeachRangeReverseContext(ctx context.Context, lo interface{}, hi interface{}, inclusiveLo bool, inclusiveHi bool, f F_Visit) error {
+	return ForeachRangeReverseContext(ctx, w.i, lo, hi, inclusiveLo, inclusiveHi, f)
+}
+
+// ForeachMinContext (see ForeachMin)
+func ForeachMinContext(ctx context.Context, w I, f F_Visit) error {
+	return WalkContext(ctx, w, minWhile(always(f)))
+}
+
+// ForeachMaxContext (see ForeachMax)
+func ForeachMaxContext(ctx context.Context, w I, f F_Visit) error {
+	return WalkContext(ctx, w, maxWhile(always(f)))
+}
+
+// ForeachRangeContext (see ForeachRange)
+func ForeachRangeContext(ctx context.Context, w I, lo interface{}, hi interface{}, inclusiveLo bool, inclusiveHi bool, f F_Visit) error {
+	return WalkContext(ctx, w, rangeWhile(lo, hi, inclusiveLo, inclusiveHi, always(f)))
+}
+
+// ForeachRangeReverseContext (see ForeachRangeReverse)
+func ForeachRangeReverseContext(ctx context.Context, w I, lo interface{}, hi interface{}, inclusiveLo bool, inclusiveHi bool, f F_Visit) error {
+	return WalkContext(ctx, w, rangeReverseWhile(lo, hi, inclusiveLo, inclusiveHi, always(f)))
+}
@@ -0,0 +1,239 @@
+package walker
+
+import (
+	"github.com/iNamik/go_bst"
+	"github.com/iNamik/go_bst/finder"
+)
+
+/**********************************************************************
+ ** WalkE
+ **********************************************************************/
+
+// I_WalkE
+type I_WalkE interface {
+	// WalkE is Walk, but reports an illegal action, a move to a node
+	// that does not exist, or a panic in f, as an error instead of
+	// panicking.  The walk stops at the offending node.
+	WalkE(f F) error
+}
+
+// t::WalkE
+func (w *t) WalkE(f F) error {
+	return WalkE(w.i, f)
+}
+
+// WalkE checks each action returned by f before passing it on to the
+// tree, and recovers panics in f, so works with any implementation of I.
+// Errors are reported as *bst.ActionError or *bst.PanicError.
+func WalkE(w I, f F) (err error) {
+	w.Walk(func(n Node) (action Action) {
+		defer func() {
+			if r := recover(); r != nil {
+				err, action = &bst.PanicError{Op: "walk", Value: r}, RETURN
+			}
+		}()
+		var ok bool
+		switch action = f(n); action {
+		case RETURN:
+			return action
+		case PREV:
+			ok = n.HasPrev()
+		case NEXT:
+			ok = n.HasNext()
+		case LEFT:
+			ok = n.HasLeft()
+		case RIGHT:
+			ok = n.HasRight()
+		case PARENT:
+			ok = n.HasParent()
+		default:
+			err = &bst.ActionError{Op: "walk", Action: action, Err: bst.ErrIllegalAction}
+			return RETURN
+		}
+		if !ok {
+			err = &bst.ActionError{Op: "walk", Action: action, Err: bst.ErrNoSuchNeighbor}
+			return RETURN
+		}
+		return action
+	})
+	return err
+}
+
+/**********************************************************************
+ ** ForeachE
+ **********************************************************************/
+
+// I_ForeachE contains the error-returning versions of the Foreach methods,
+// which report a panic in f as a *bst.PanicError, stopping the iteration
+type I_ForeachE interface {
+	ForeachMinE(f F_Visit) error
+	ForeachMaxE(f F_Visit) error
+	ForeachRangeE(lo interface{}, hi interface{}, inclusiveLo bool, inclusiveHi bool, f F_Visit) error
+	ForeachRangeReverseE(lo interface{}, hi interface{}, inclusiveLo bool, inclusiveHi bool, f F_Visit) error
+	ForeachMinWhileE(f F_VisitWhile) error
+	ForeachMaxWhileE(f F_VisitWhile) error
+	ForeachRangeWhileE(lo interface{}, hi interface{}, inclusiveLo bool, inclusiveHi bool, f F_VisitWhile) error
+	ForeachRangeReverseWhileE(lo interface{}, hi interface{}, inclusiveLo bool, inclusiveHi bool, f F_VisitWhile) error
+}
+
+// t::ForeachMinE
+func (w *t) ForeachMinE(f F_Visit) error {
+	return ForeachMinE(w.i, f)
+}
+
+// t::ForeachMaxE
+func (w *t) ForeachMaxE(f F_Visit) error {
+	return ForeachMaxE(w.i, f)
+}
+
+// t::ForeachRangeE
+func (w *t) ForeachRangeE(lo interface{}, hi interface{}, inclusiveLo bool, inclusiveHi bool, f F_Visit) error {
+	return ForeachRangeE(w.i, lo, hi, inclusiveLo, inclusiveHi, f)
+}
+
+// t::ForeachRangeReverseE
+func (w *t) ForeachRangeReverseE(lo interface{}, hi interface{}, inclusiveLo bool, inclusiveHi bool, f F_Visit) error {
+	return ForeachRangeReverseE(w.i, lo, hi, inclusiveLo, inclusiveHi, f)
+}
+
+// t::ForeachMinWhileE
+func (w *t) ForeachMinWhileE(f F_VisitWhile) error {
+	return ForeachMinWhileE(w.i, f)
+}
+
+// t::ForeachMaxWhileE
+func (w *t) ForeachMaxWhileE(f F_VisitWhile) error {
+	return ForeachMaxWhileE(w.i, f)
+}
+
+// t::ForeachRangeWhileE
+func (w *t) ForeachRangeWhileE(lo interface{}, hi interface{}, inclusiveLo bool, inclusiveHi bool, f F_VisitWhile) error {
+	return ForeachRangeWhileE(w.i, lo, hi, inclusiveLo, inclusiveHi, f)
+}
+
+// t::ForeachRangeReverseWhileE
+func (w *t) ForeachRangeReverseWhileE(lo interface{}, hi interface{}, inclusiveLo bool, inclusiveHi bool, f F_VisitWhile) error {
+	return ForeachRangeReverseWhileE(w.i, lo, hi, inclusiveLo, inclusiveHi, f)
+}
+
+// ForeachMinE (see ForeachMin)
+func ForeachMinE(w I, f F_Visit) error {
+	return ForeachMinWhileE(w, always(f))
+}
+
+// ForeachMaxE (see ForeachMax)
+func ForeachMaxE(w I, f F_Visit) error {
+	return ForeachMaxWhileE(w, always(f))
+}
+
+// ForeachRangeE (see ForeachRange)
+func ForeachRangeE(w I, lo interface{}, hi interface{}, inclusiveLo bool, inclusiveHi bool, f F_Visit) error {
+	return ForeachRangeWhileE(w, lo, hi, inclusiveLo, inclusiveHi, always(f))
+}
+
+// ForeachRangeReverseE (see ForeachRangeReverse)
+func ForeachRangeReverseE(w I, lo interface{}, hi interface{}, inclusiveLo bool, inclusiveHi bool, f F_Visit) error {
+	return ForeachRangeReverseWhileE(w, lo, hi, inclusiveLo, inclusiveHi, always(f))
+}
+
+// ForeachMinWhileE (see ForeachMinWhile)
+func ForeachMinWhileE(w I, f F_VisitWhile) error {
+	return WalkE(w, minWhile(f))
+}
+
+// ForeachMaxWhileE (see ForeachMaxWhile)
+func ForeachMaxWhileE(w I, f F_VisitWhile) error {
+	return WalkE(w, maxWhile(f))
+}
+
+// ForeachRangeWhileE (see ForeachRangeWhile)
+func ForeachRangeWhileE(w I, lo interface{}, hi interface{}, inclusiveLo bool, inclusiveHi bool, f F_VisitWhile) error {
+	return WalkE(w, rangeWhile(lo, hi, inclusiveLo, inclusiveHi, f))
+}
+
+// ForeachRangeReverseWhileE (see ForeachRangeReverseWhile)
+func ForeachRangeReverseWhileE(w I, lo interface{}, hi interface{}, inclusiveLo bool, inclusiveHi bool, f F_VisitWhile) error {
+	return WalkE(w, rangeReverseWhile(lo, hi, inclusiveLo, inclusiveHi, f))
+}
+
+/**********************************************************************
+ ** HelpersE
+ **********************************************************************/
+
+// I_HelpersE
+// matches finder.I_HelpersE
+type I_HelpersE interface {
+	finder.I_HelpersE
+}
+
+// t::GetE
+func (w *t) GetE(key interface{}) (interface{}, bool, error) {
+	return GetE(w.i, key)
+}
+
+// t::MinE
+func (w *t) MinE() (interface{}, interface{}, bool, error) {
+	return MinE(w.i)
+}
+
+// t::MaxE
+func (w *t) MaxE() (interface{}, interface{}, bool, error) {
+	return MaxE(w.i)
+}
+
+// t::LowerBoundE
+func (w *t) LowerBoundE(boundKey interface{}) (interface{}, interface{}, bool, error) {
+	return LowerBoundE(w.i, boundKey)
+}
+
+// t::UpperBoundE
+func (w *t) UpperBoundE(boundKey interface{}) (interface{}, interface{}, bool, error) {
+	return UpperBoundE(w.i, boundKey)
+}
+
+// GetE (see Get)
+func GetE(w I, key interface{}) (value interface{}, found bool, err error) {
+	if value, found = get(walkE(w, &err), key); err != nil {
+		return nil, false, err
+	}
+	return value, found, nil
+}
+
+// MinE (see Min)
+func MinE(w I) (key interface{}, value interface{}, found bool, err error) {
+	if key, value, found = walkMin(walkE(w, &err)); err != nil {
+		return nil, nil, false, err
+	}
+	return key, value, found, nil
+}
+
+// MaxE (see Max)
+func MaxE(w I) (key interface{}, value interface{}, found bool, err error) {
+	if key, value, found = walkMax(walkE(w, &err)); err != nil {
+		return nil, nil, false, err
+	}
+	return key, value, found, nil
+}
+
+// LowerBoundE (see LowerBound)
+func LowerBoundE(w I, boundKey interface{}) (key interface{}, value interface{}, found bool, err error) {
+	if key, value, found = lowerBound(walkE(w, &err), boundKey); err != nil {
+		return nil, nil, false, err
+	}
+	return key, value, found, nil
+}
+
+// UpperBoundE (see UpperBound)
+func UpperBoundE(w I, boundKey interface{}) (key interface{}, value interface{}, found bool, err error) {
+	if key, value, found = upperBound(walkE(w, &err), boundKey); err != nil {
+		return nil, nil, false, err
+	}
+	return key, value, found, nil
+}
+
+// walkE adapts WalkE to the signature of I.Walk, storing any error in err
+func walkE(w I, err *error) walkFunc {
+	return func(f F) {
+		*err = WalkE(w, f)
+	}
+}
@@ -0,0 +1,79 @@
+package walker
+
+import (
+	"iter"
+)
+
+/**********************************************************************
+ ** Iterators
+ **********************************************************************/
+
+// I_Seq contains the methods returning range-over-func iterators.
+// Each iteration runs within a single Walk, so the loop body must not
+// call back into the same tree (see the tree's Walk).
+type I_Seq interface {
+	// All iterates over the tree, starting at the minimum value
+	All() iter.Seq2[interface{}, interface{}]
+	// Backward iterates over the tree, starting at the maximum value
+	Backward() iter.Seq2[interface{}, interface{}]
+	// Range iterates over the keys between lo and hi, starting at the least
+	Range(lo interface{}, hi interface{}, inclusiveLo bool, inclusiveHi bool) iter.Seq2[interface{}, interface{}]
+	// RangeBackward iterates over the keys between lo and hi, starting at the greatest
+	RangeBackward(lo interface{}, hi interface{}, inclusiveLo bool, inclusiveHi bool) iter.Seq2[interface{}, interface{}]
+}
+
+// t::All
+func (w *t) All() iter.Seq2[interface{}, interface{}] {
+	return All(w.i)
+}
+
+// t::Backward
+func (w *t) Backward() iter.Seq2[interface{}, interface{}] {
+	return Backward(w.i)
+}
+
+// t::Range
+func (w *t) Range(lo interface{}, hi interface{}, inclusiveLo bool, inclusiveHi bool) iter.Seq2[interface{}, interface{}] {
+	return Range(w.i, lo, hi, inclusiveLo, inclusiveHi)
+}
+
+// t::RangeBackward
+func (w *t) RangeBackward(lo interface{}, hi interface{}, inclusiveLo bool, inclusiveHi bool) iter.Seq2[interface{}, interface{}] {
+	return RangeBackward(w.i, lo, hi, inclusiveLo, inclusiveHi)
+}
+
+// All returns an iterator over the keys and values of w, in ascending
+// order, for use with range:
+//
+//	for k, v := range walker.All(tree) { ... }
+//
+// Breaking out of the loop stops the walk (see ForeachMinWhile).
+func All(w I) iter.Seq2[interface{}, interface{}] {
+	return func(yield func(interface{}, interface{}) bool) {
+		ForeachMinWhile(w, yield)
+	}
+}
+
+// Backward returns an iterator over the keys and values of w,
+// in descending order (see ForeachMaxWhile)
+func Backward(w I) iter.Seq2[interface{}, interface{}] {
+	return func(yield func(interface{}, interface{}) bool) {
+		ForeachMaxWhile(w, yield)
+	}
+}
+
+// Range returns an iterator over the keys and values of w between lo
+// and hi, in ascending order (see ForeachRangeWhile)
+func Range(w I, lo interface{}, hi interface{}, inclusiveLo bool, inclusiveHi bool) iter.Seq2[interface{}, interface{}] {
+	return func(yield func(interface{}, interface{}) bool) {
+		ForeachRangeWhile(w, lo, hi, inclusiveLo, inclusiveHi, yield)
+	}
+}
+
+// RangeBackward returns an iterator over the keys and values of w between
+// lo and hi, in descending order (see ForeachRangeReverseWhile)
+func RangeBackward(w I, lo interface{}, hi interface{}, inclusiveLo bool, inclusiveHi bool) iter.Seq2[interface{}, interface{}] {
+	return func(yield func(interface{}, interface{}) bool) {
+		ForeachRangeReverseWhile(w, lo, hi, inclusiveLo, inclusiveHi, yield)
+	}
+}
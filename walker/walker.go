@@ -61,6 +61,49 @@ This package uses Walk to implement several BST methods:
  * UpperBound (see finder.I_UpperBound)
  * ForeachMin (see walker.I_ForeachMin)
  * ForeachMax (see walker.I_ForeachMax)
+ * ForeachRange (see walker.I_ForeachRange)
+ * ForeachRangeReverse (see walker.I_ForeachRangeReverse)
+ * ForeachMinWhile (see walker.I_ForeachMinWhile)
+ * ForeachMaxWhile (see walker.I_ForeachMaxWhile)
+ * ForeachRangeWhile (see walker.I_ForeachRangeWhile)
+ * ForeachRangeReverseWhile (see walker.I_ForeachRangeReverseWhile)
+ * All, Backward, Range, RangeBackward (see walker.I_Seq)
+
+All, Backward, Range and RangeBackward return iterators for use with
+range, stopping the walk when the loop breaks:
+
+	for k, v := range walker.All(tree) {
+		...
+	}
+
+
+Errors
+------
+
+Implementations typically panic when the Walk call-back returns an
+unknown action, or a move to a node that does not exist (e.g.
+LEFT when HasLeft() == false).  WalkE reports these as a
+*bst.ActionError (wrapping bst.ErrIllegalAction or
+bst.ErrNoSuchNeighbor), and a panic in the call-back as a
+*bst.PanicError, instead of panicking.  The walk stops at the
+offending node.
+
+WalkE checks each action before passing it on to the tree, so it
+works with any implementation of Walk.
+
+The ForeachXxxE variants of the Foreach helpers report a panic in
+their call-back the same way, stopping the iteration.  GetE, MinE,
+MaxE, LowerBoundE and UpperBoundE report a panic in the comparator.
+
+
+Cancellation
+------------
+
+WalkContext, and the ForeachXxxContext variants of the Foreach
+helpers, check a context.Context before visiting each node, stopping
+the walk and returning ctx.Err() once the context is done.  This
+bounds how long a walk holds the tree's lock, e.g. to a request's
+deadline.  Other errors are reported as per WalkE.
 
 
 Efficiency
@@ -108,9 +151,20 @@ import (
 // T specifies an interface for all of the functions that walker supports.
 // extends finder.T
 type T interface {
-	finder.T // extends finder.T
+	finder.T // extends finder.T (including finder.I_HelpersE)
 	I_ForeachMin
 	I_ForeachMax
+	I_ForeachRange
+	I_ForeachRangeReverse
+	I_ForeachMinWhile
+	I_ForeachMaxWhile
+	I_ForeachRangeWhile
+	I_ForeachRangeReverseWhile
+	I_WalkE
+	I_ForeachE
+	I_WalkContext
+	I_ForeachContext
+	I_Seq
 }
 
 // I defines the extensible walker interface
@@ -124,6 +178,13 @@ type F func(Node) Action
 // F_Visit defines a function for visiting nodes without controls (i.e. iteration)
 type F_Visit func(key interface{}, value interface{})
 
+// F_VisitWhile defines a function for visiting nodes that can stop the
+// iteration early, by returning false
+type F_VisitWhile func(key interface{}, value interface{}) (next bool)
+
+// walkFunc is the signature of I.Walk, which the helper functions are built on
+type walkFunc func(f F)
+
 type t struct{ i I }
 
 /**********************************************************************
@@ -200,7 +261,12 @@ func (w *t) Get(key interface{}) (value interface{}, found bool) {
 
 // Get
 func Get(w I, key interface{}) (value interface{}, found bool) {
-	w.Walk(func(node Node) Action {
+	return get(w.Walk, key)
+}
+
+// get implements Get using walk, which is either I.Walk or WalkE
+func get(walk walkFunc, key interface{}) (value interface{}, found bool) {
+	walk(func(node Node) Action {
 		switch node.Cmp(key, node.Key()) {
 		case cmp.LT:
 			if node.HasLeft() == true {
@@ -239,8 +305,13 @@ func (w *t) Min() (key interface{}, value interface{}, found bool) {
 
 // Min
 func Min(w I) (key interface{}, value interface{}, found bool) {
+	return walkMin(w.Walk)
+}
+
+// walkMin implements Min using walk
+func walkMin(walk walkFunc) (key interface{}, value interface{}, found bool) {
 	key, value, found = nil, nil, false
-	w.Walk(func(node Node) Action {
+	walk(func(node Node) Action {
 		if node.HasLeft() {
 			return LEFT
 		}
@@ -267,8 +338,13 @@ func (w *t) Max() (interface{} /* key */, interface{} /* value */, bool /* found
 
 // Max
 func Max(w I) (key interface{}, value interface{}, found bool) {
+	return walkMax(w.Walk)
+}
+
+// walkMax implements Max using walk
+func walkMax(walk walkFunc) (key interface{}, value interface{}, found bool) {
 	key, value, found = nil, nil, false
-	w.Walk(func(node Node) Action {
+	walk(func(node Node) Action {
 		if node.HasRight() {
 			return RIGHT
 		}
@@ -295,8 +371,13 @@ func (w *t) LowerBound(boundKey interface{}) (key interface{}, value interface{}
 
 // LowerBound
 func LowerBound(w I, boundKey interface{}) (key interface{}, value interface{}, found bool) {
+	return lowerBound(w.Walk, boundKey)
+}
+
+// lowerBound implements LowerBound using walk
+func lowerBound(walk walkFunc, boundKey interface{}) (key interface{}, value interface{}, found bool) {
 	key, value, found = nil, nil, false
-	w.Walk(func(node Node) Action {
+	walk(func(node Node) Action {
 		switch node.Cmp(node.Key(), boundKey) {
 		case cmp.LT: // node < boundKey - We have a candidate
 			// If node > working then update working
@@ -342,8 +423,13 @@ func (w *t) UpperBound(boundKey interface{}) (key interface{}, value interface{}
 
 // UpperBound
 func UpperBound(w I, boundKey interface{}) (key interface{}, value interface{}, found bool) {
+	return upperBound(w.Walk, boundKey)
+}
+
+// upperBound implements UpperBound using walk
+func upperBound(walk walkFunc, boundKey interface{}) (key interface{}, value interface{}, found bool) {
 	key, value, found = nil, nil, false
-	w.Walk(func(node Node) Action {
+	walk(func(node Node) Action {
 		switch node.Cmp(node.Key(), boundKey) {
 		case cmp.GT: // node > boundKey - We have a candidate
 			// If node < working then update working
@@ -390,20 +476,7 @@ func (w *t) ForeachMin(f F_Visit) {
 
 // ForeachMin
 func ForeachMin(w I, f F_Visit) {
-	haveMin := false
-	w.Walk(func(n Node) Action {
-		if haveMin == false {
-			if n.HasLeft() == true {
-				return LEFT
-			}
-			haveMin = true
-		}
-		f(n.Key(), n.Value())
-		if n.HasNext() {
-			return NEXT
-		}
-		return RETURN
-	})
+	ForeachMinWhile(w, always(f))
 }
 
 /**********************************************************************
@@ -424,18 +497,290 @@ func (w *t) ForeachMax(f F_Visit) {
 
 // ForeachMax
 func ForeachMax(w I, f F_Visit) {
+	ForeachMaxWhile(w, always(f))
+}
+
+/**********************************************************************
+ ** ForeachRange
+ **********************************************************************/
+
+// I_ForeachRange
+type I_ForeachRange interface {
+	// ForeachRange iterates sequentially over the keys between lo and hi,
+	// starting at the least key in the range.  inclusiveLo and inclusiveHi
+	// determine whether lo and hi, respectively, are part of the range.
+	ForeachRange(lo interface{}, hi interface{}, inclusiveLo bool, inclusiveHi bool, f F_Visit)
+}
+
+// t::ForeachRange
+func (w *t) ForeachRange(lo interface{}, hi interface{}, inclusiveLo bool, inclusiveHi bool, f F_Visit) {
+	ForeachRange(w.i, lo, hi, inclusiveLo, inclusiveHi, f)
+}
+
+// ForeachRange seeks directly to the least key in the range, rather than
+// starting at the minimum, and stops as soon as a key is beyond the range.
+func ForeachRange(w I, lo interface{}, hi interface{}, inclusiveLo bool, inclusiveHi bool, f F_Visit) {
+	ForeachRangeWhile(w, lo, hi, inclusiveLo, inclusiveHi, always(f))
+}
+
+/**********************************************************************
+ ** ForeachRangeReverse
+ **********************************************************************/
+
+// I_ForeachRangeReverse
+type I_ForeachRangeReverse interface {
+	// ForeachRangeReverse iterates sequentially over the keys between lo and hi,
+	// starting at the greatest key in the range, and ending at the least.
+	// inclusiveLo and inclusiveHi determine whether lo and hi, respectively,
+	// are part of the range.
+	ForeachRangeReverse(lo interface{}, hi interface{}, inclusiveLo bool, inclusiveHi bool, f F_Visit)
+}
+
+// t::ForeachRangeReverse
+func (w *t) ForeachRangeReverse(lo interface{}, hi interface{}, inclusiveLo bool, inclusiveHi bool, f F_Visit) {
+	ForeachRangeReverse(w.i, lo, hi, inclusiveLo, inclusiveHi, f)
+}
+
+// ForeachRangeReverse seeks directly to the greatest key in the range, rather
+// than starting at the maximum, and stops as soon as a key is beyond the range.
+func ForeachRangeReverse(w I, lo interface{}, hi interface{}, inclusiveLo bool, inclusiveHi bool, f F_Visit) {
+	ForeachRangeReverseWhile(w, lo, hi, inclusiveLo, inclusiveHi, always(f))
+}
+
+/**********************************************************************
+ ** ForeachMinWhile
+ **********************************************************************/
+
+// I_ForeachMinWhile
+type I_ForeachMinWhile interface {
+	// ForeachMinWhile iterates sequentially over the the tree,
+	// starting at the minimum value, until f returns false
+	ForeachMinWhile(f F_VisitWhile)
+}
+
+// t::ForeachMinWhile
+func (w *t) ForeachMinWhile(f F_VisitWhile) {
+	ForeachMinWhile(w.i, f)
+}
+
+// ForeachMinWhile
+func ForeachMinWhile(w I, f F_VisitWhile) {
+	w.Walk(minWhile(f))
+}
+
+// minWhile creates the walk call-back for ForeachMinWhile
+func minWhile(f F_VisitWhile) F {
+	haveMin := false
+	return func(n Node) Action {
+		if haveMin == false {
+			if n.HasLeft() == true {
+				return LEFT
+			}
+			haveMin = true
+		}
+		if f(n.Key(), n.Value()) == false {
+			return RETURN
+		}
+		if n.HasNext() {
+			return NEXT
+		}
+		return RETURN
+	}
+}
+
+/**********************************************************************
+ ** ForeachMaxWhile
+ **********************************************************************/
+
+// I_ForeachMaxWhile
+type I_ForeachMaxWhile interface {
+	// ForeachMaxWhile iterates sequentially over the the tree,
+	// starting at the maximum value, until f returns false
+	ForeachMaxWhile(f F_VisitWhile)
+}
+
+// t::ForeachMaxWhile
+func (w *t) ForeachMaxWhile(f F_VisitWhile) {
+	ForeachMaxWhile(w.i, f)
+}
+
+// ForeachMaxWhile
+func ForeachMaxWhile(w I, f F_VisitWhile) {
+	w.Walk(maxWhile(f))
+}
+
+// maxWhile creates the walk call-back for ForeachMaxWhile
+func maxWhile(f F_VisitWhile) F {
 	haveMax := false
-	w.Walk(func(n Node) Action {
+	return func(n Node) Action {
 		if haveMax == false {
 			if n.HasRight() == true {
 				return RIGHT
 			}
 			haveMax = true
 		}
-		f(n.Key(), n.Value())
+		if f(n.Key(), n.Value()) == false {
+			return RETURN
+		}
 		if n.HasPrev() {
 			return PREV
 		}
 		return RETURN
-	})
+	}
+}
+
+/**********************************************************************
+ ** ForeachRangeWhile
+ **********************************************************************/
+
+// I_ForeachRangeWhile
+type I_ForeachRangeWhile interface {
+	// ForeachRangeWhile iterates sequentially over the keys between lo and hi,
+	// starting at the least key in the range, until f returns false.
+	ForeachRangeWhile(lo interface{}, hi interface{}, inclusiveLo bool, inclusiveHi bool, f F_VisitWhile)
+}
+
+// t::ForeachRangeWhile
+func (w *t) ForeachRangeWhile(lo interface{}, hi interface{}, inclusiveLo bool, inclusiveHi bool, f F_VisitWhile) {
+	ForeachRangeWhile(w.i, lo, hi, inclusiveLo, inclusiveHi, f)
+}
+
+// ForeachRangeWhile (see ForeachRange)
+func ForeachRangeWhile(w I, lo interface{}, hi interface{}, inclusiveLo bool, inclusiveHi bool, f F_VisitWhile) {
+	w.Walk(rangeWhile(lo, hi, inclusiveLo, inclusiveHi, f))
+}
+
+// rangeWhile creates the walk call-back for ForeachRangeWhile
+func rangeWhile(lo interface{}, hi interface{}, inclusiveLo bool, inclusiveHi bool, f F_VisitWhile) F {
+	seeking := true
+	return func(n Node) Action {
+		if seeking {
+			// If node is below the range
+			if isBelow(n, lo, inclusiveLo) {
+				if n.HasRight() {
+					return RIGHT
+				}
+				// No greater key in this subtree, so the least key
+				// in the range (if any) is the next node
+				if n.HasNext() == false {
+					return RETURN
+				}
+				seeking = false
+				return NEXT
+			}
+			// Node is in the range, is there a lesser key that might be?
+			if n.HasLeft() {
+				return LEFT
+			}
+			seeking = false
+		}
+		if isAbove(n, hi, inclusiveHi) {
+			return RETURN
+		}
+		if f(n.Key(), n.Value()) == false {
+			return RETURN
+		}
+		if n.HasNext() {
+			return NEXT
+		}
+		return RETURN
+	}
+}
+
+/**********************************************************************
+ ** ForeachRangeReverseWhile
+ **********************************************************************/
+
+// I_ForeachRangeReverseWhile
+type I_ForeachRangeReverseWhile interface {
+	// ForeachRangeReverseWhile iterates sequentially over the keys between lo and hi,
+	// starting at the greatest key in the range, until f returns false.
+	ForeachRangeReverseWhile(lo interface{}, hi interface{}, inclusiveLo bool, inclusiveHi bool, f F_VisitWhile)
+}
+
+// t::ForeachRangeReverseWhile
+func (w *t) ForeachRangeReverseWhile(lo interface{}, hi interface{}, inclusiveLo bool, inclusiveHi bool, f F_VisitWhile) {
+	ForeachRangeReverseWhile(w.i, lo, hi, inclusiveLo, inclusiveHi, f)
+}
+
+// ForeachRangeReverseWhile (see ForeachRangeReverse)
+func ForeachRangeReverseWhile(w I, lo interface{}, hi interface{}, inclusiveLo bool, inclusiveHi bool, f F_VisitWhile) {
+	w.Walk(rangeReverseWhile(lo, hi, inclusiveLo, inclusiveHi, f))
+}
+
+// rangeReverseWhile creates the walk call-back for ForeachRangeReverseWhile
+func rangeReverseWhile(lo interface{}, hi interface{}, inclusiveLo bool, inclusiveHi bool, f F_VisitWhile) F {
+	seeking := true
+	return func(n Node) Action {
+		if seeking {
+			// If node is above the range
+			if isAbove(n, hi, inclusiveHi) {
+				if n.HasLeft() {
+					return LEFT
+				}
+				// No lesser key in this subtree, so the greatest key
+				// in the range (if any) is the previous node
+				if n.HasPrev() == false {
+					return RETURN
+				}
+				seeking = false
+				return PREV
+			}
+			// Node is in the range, is there a greater key that might be?
+			if n.HasRight() {
+				return RIGHT
+			}
+			seeking = false
+		}
+		if isBelow(n, lo, inclusiveLo) {
+			return RETURN
+		}
+		if f(n.Key(), n.Value()) == false {
+			return RETURN
+		}
+		if n.HasPrev() {
+			return PREV
+		}
+		return RETURN
+	}
+}
+
+/**********************************************************************
+ ** Iteration Helpers
+ **********************************************************************/
+
+// always adapts an F_Visit into an F_VisitWhile that never stops
+func always(f F_Visit) F_VisitWhile {
+	return func(key interface{}, value interface{}) bool {
+		f(key, value)
+		return true
+	}
+}
+
+/**********************************************************************
+ ** Range Helpers
+ **********************************************************************/
+
+// isBelow returns true if node is less than the lower bound of a range
+func isBelow(n Node, lo interface{}, inclusiveLo bool) bool {
+	switch n.Cmp(n.Key(), lo) {
+	case cmp.LT:
+		return true
+	case cmp.GT:
+		return false
+	default:
+		return inclusiveLo == false
+	}
+}
+
+// isAbove returns true if node is greater than the upper bound of a range
+func isAbove(n Node, hi interface{}, inclusiveHi bool) bool {
+	switch n.Cmp(n.Key(), hi) {
+	case cmp.GT:
+		return true
+	case cmp.LT:
+		return false
+	default:
+		return inclusiveHi == false
+	}
 }
@@ -4,11 +4,14 @@ package walker_test
 //import . "github.com/iNamik/go_pkg/debug/ping"
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"testing"
 )
 
 import (
+	"github.com/iNamik/go_bst"
 	"github.com/iNamik/go_bst/walker"
 	"github.com/iNamik/go_cmp"
 )
@@ -90,6 +93,86 @@ func (n *node) HasParent() bool {
 	return n.hasParent
 }
 
+/**********************************************************************
+ ** tree
+ **********************************************************************/
+
+// tree is a balanced tree of the keys 0..n-1 (with value == key),
+// whose nodes are linked by index, -1 meaning no node
+type tree struct {
+	root   int
+	left   []int
+	right  []int
+	parent []int
+	level  []int
+}
+
+// newTree creates a tree of n keys
+func newTree(n int) *tree {
+	r := &tree{left: make([]int, n), right: make([]int, n), parent: make([]int, n), level: make([]int, n)}
+	r.root = r.build(0, n, -1, 1)
+	return r
+}
+
+// tree::build links the keys lo..hi-1 under parent, returning their root
+func (r *tree) build(lo int, hi int, parent int, level int) int {
+	if lo >= hi {
+		return -1
+	}
+	i := (lo + hi) / 2
+	r.parent[i], r.level[i] = parent, level
+	r.left[i] = r.build(lo, i, i, level+1)
+	r.right[i] = r.build(i+1, hi, i, level+1)
+	return i
+}
+
+// tree::node creates the node for key i
+func (r *tree) node(i int) node {
+	n := len(r.left)
+	return node{key: i, value: i, level: r.level[i], hasPrev: i > 0, hasNext: i < n-1,
+		hasLeft: r.left[i] >= 0, hasRight: r.right[i] >= 0, hasParent: r.parent[i] >= 0}
+}
+
+// tree::Walk follows the actions returned by f, failing on a move to a node
+// that does not exist
+func (r *tree) Walk(f walker.F, t *testing.T) {
+	for i := r.root; i >= 0; {
+		n := r.node(i)
+		switch action := f(&n); action {
+		case walker.RETURN:
+			return
+		case walker.PREV:
+			i = r.move(n.hasPrev, i-1, action, t)
+		case walker.NEXT:
+			i = r.move(n.hasNext, i+1, action, t)
+		case walker.LEFT:
+			i = r.move(n.hasLeft, r.left[i], action, t)
+		case walker.RIGHT:
+			i = r.move(n.hasRight, r.right[i], action, t)
+		case walker.PARENT:
+			i = r.move(n.hasParent, r.parent[i], action, t)
+		default:
+			t.Fatalf("walk returned '%s'", action)
+		}
+	}
+}
+
+// tree::move returns to if ok, otherwise fails
+func (r *tree) move(ok bool, to int, action walker.Action, t *testing.T) int {
+	if ok == false {
+		t.Fatalf("walk returned '%s' with no such neighbor", action)
+	}
+	return to
+}
+
+// newWalker creates a walker.T over a tree of the keys 0..n-1
+func newWalker(n int, t *testing.T) walker.T {
+	r := newTree(n)
+	return walker.New((f_walk)(func(f walker.F) {
+		r.Walk(f, t)
+	}))
+}
+
 /**********************************************************************
  ** Assert Functions
  **********************************************************************/
@@ -112,6 +195,71 @@ func assertActionString(action walker.Action, value string, t *testing.T) {
 	}
 }
 
+// assertActionError confirms that err is a *bst.ActionError wrapping target
+func assertActionError(err error, target error, msg string, t *testing.T) {
+	var e *bst.ActionError
+	if errors.As(err, &e) == false || errors.Is(err, target) == false {
+		t.Fatalf("returned error '%v' instead of a *bst.ActionError wrapping '%v'", err, target)
+	}
+	if err.Error() != msg {
+		t.Fatalf("returned error '%s' instead of '%s'", err, msg)
+	}
+}
+
+// assertPanicError confirms that err is a *bst.PanicError
+func assertPanicError(err error, t *testing.T) {
+	var e *bst.PanicError
+	if errors.As(err, &e) == false {
+		t.Fatalf("returned error '%v' instead of a *bst.PanicError", err)
+	}
+}
+
+// assertKeys confirms that keys holds the expected keys, in order
+func assertKeys(name string, keys []int, expected []int, t *testing.T) {
+	if fmt.Sprint(keys) != fmt.Sprint(expected) {
+		t.Fatalf("%s visited %v instead of %v", name, keys, expected)
+	}
+}
+
+// collect returns an F_Visit that appends each key to keys, checking its value
+func collect(keys *[]int, t *testing.T) walker.F_Visit {
+	return func(key interface{}, value interface{}) {
+		if key != value {
+			t.Fatalf("key '%v' has value '%v'", key, value)
+		}
+		*keys = append(*keys, key.(int))
+	}
+}
+
+// reverse returns a reversed copy of keys
+func reverse(keys []int) []int {
+	r := make([]int, 0, len(keys))
+	for i := len(keys) - 1; i >= 0; i-- {
+		r = append(r, keys[i])
+	}
+	return r
+}
+
+// rangeTests are ranges over a tree of the keys 0..6
+var rangeTests = []struct {
+	lo, hi       int
+	incLo, incHi bool
+	keys         []int
+}{
+	{2, 4, TRUE_, TRUE_, []int{2, 3, 4}},
+	{2, 4, FALSE, FALSE, []int{3}},
+	{2, 4, TRUE_, FALSE, []int{2, 3}},
+	{2, 4, FALSE, TRUE_, []int{3, 4}},
+	{3, 3, TRUE_, TRUE_, []int{3}},
+	{3, 3, FALSE, TRUE_, []int{}},
+	{3, 4, FALSE, FALSE, []int{}},
+	{4, 2, TRUE_, TRUE_, []int{}}, // lo > hi
+	{-5, 10, TRUE_, TRUE_, []int{0, 1, 2, 3, 4, 5, 6}},
+	{0, 6, FALSE, FALSE, []int{1, 2, 3, 4, 5}},
+	{7, 10, TRUE_, TRUE_, []int{}},
+	{-5, -1, TRUE_, TRUE_, []int{}},
+}
+
 /**********************************************************************
  ** Test Functions
  **********************************************************************/
@@ -440,3 +588,439 @@ func Test_Action_String(t *testing.T) {
 
 	assertActionString(walker.Action(-1), "walker.Action(-1)", t)
 }
+
+// Test_WalkE
+func Test_WalkE(t *testing.T) {
+	nodes := []node{
+		{key: 0, value: 0, level: 1, hasPrev: FALSE, hasNext: TRUE_, hasLeft: FALSE, hasRight: TRUE_, hasParent: FALSE, action: walker.RIGHT},
+		{key: 1, value: 1, level: 2, hasPrev: TRUE_, hasNext: FALSE, hasLeft: FALSE, hasRight: FALSE, hasParent: TRUE_, action: walker.RETURN},
+	}
+	w := (f_walk)(func(f walker.F) {
+		assertWalk(f, nodes, t)
+	})
+	err := walker.WalkE(w, func(n walker.Node) walker.Action {
+		if n.HasRight() {
+			return walker.RIGHT
+		}
+		return walker.RETURN
+	})
+	if err != nil {
+		t.Fatalf("WalkE() returned '%v'", err)
+	}
+}
+
+// Test_WalkE_NoSuchNeighbor
+func Test_WalkE_NoSuchNeighbor(t *testing.T) {
+	nodes := []node{
+		// The move is replaced with RETURN, stopping the walk
+		{key: 0, value: 0, level: 1, hasPrev: FALSE, hasNext: FALSE, hasLeft: FALSE, hasRight: FALSE, hasParent: FALSE, action: walker.RETURN},
+	}
+	w := (f_walk)(func(f walker.F) {
+		assertWalk(f, nodes, t)
+	})
+	for _, action := range []walker.Action{walker.PREV, walker.NEXT, walker.LEFT, walker.RIGHT, walker.PARENT} {
+		err := walker.WalkE(w, func(walker.Node) walker.Action {
+			return action
+		})
+		assertActionError(err, bst.ErrNoSuchNeighbor, fmt.Sprintf("walk: no such neighbor '%s'", action), t)
+	}
+	err := walker.WalkE(w, func(walker.Node) walker.Action {
+		return walker.Action(-1)
+	})
+	assertActionError(err, bst.ErrIllegalAction, "walk: illegal action 'walker.Action(-1)'", t)
+	err = walker.WalkE(w, func(walker.Node) walker.Action {
+		panic("oops")
+	})
+	assertPanicError(err, t)
+}
+
+// Test_GetE
+func Test_GetE(t *testing.T) {
+	const VALUE = 1
+	nodes := []node{
+		{key: 0, value: 0, level: 1, hasPrev: FALSE, hasNext: TRUE_, hasLeft: FALSE, hasRight: TRUE_, hasParent: FALSE, action: walker.RIGHT},
+		{key: 2, value: 2, level: 2, hasPrev: TRUE_, hasNext: FALSE, hasLeft: TRUE_, hasRight: FALSE, hasParent: TRUE_, action: walker.LEFT},
+		{key: 1, value: 1, level: 3, hasPrev: TRUE_, hasNext: TRUE_, hasLeft: FALSE, hasRight: FALSE, hasParent: TRUE_, action: walker.RETURN},
+	}
+	w := walker.New((f_walk)(func(f walker.F) {
+		assertWalk(f, nodes, t)
+	}))
+	value, found, err := w.GetE(VALUE)
+	if value != VALUE || found != true || err != nil {
+		t.Fatalf("GetE() returned (%v, %v, %v)", value, found, err)
+	}
+}
+
+// Test_GetE_Panic confirms that a panic in the comparator is reported
+func Test_GetE_Panic(t *testing.T) {
+	nodes := []node{
+		{key: 0, value: 0, level: 1, hasPrev: FALSE, hasNext: TRUE_, hasLeft: FALSE, hasRight: TRUE_, hasParent: FALSE, action: walker.RETURN},
+	}
+	w := walker.New((f_walk)(func(f walker.F) {
+		assertWalk(f, nodes, t)
+	}))
+	value, found, err := w.GetE("1") // node::Cmp only takes ints
+	if value != nil || found != false {
+		t.Fatalf("GetE() returned (%v, %v)", value, found)
+	}
+	assertPanicError(err, t)
+}
+
+// Test_MinE
+func Test_MinE(t *testing.T) {
+	nodes := []node{
+		{key: 1, value: 1, level: 1, hasPrev: TRUE_, hasNext: FALSE, hasLeft: TRUE_, hasRight: FALSE, hasParent: FALSE, action: walker.LEFT},
+		{key: 0, value: 0, level: 2, hasPrev: FALSE, hasNext: TRUE_, hasLeft: FALSE, hasRight: FALSE, hasParent: TRUE_, action: walker.RETURN},
+	}
+	w := walker.New((f_walk)(func(f walker.F) {
+		assertWalk(f, nodes, t)
+	}))
+	key, value, found, err := w.MinE()
+	if key != 0 || value != 0 || found != true || err != nil {
+		t.Fatalf("MinE() returned (%v, %v, %v, %v)", key, value, found, err)
+	}
+}
+
+// Test_MaxE
+func Test_MaxE(t *testing.T) {
+	nodes := []node{
+		{key: 0, value: 0, level: 1, hasPrev: FALSE, hasNext: TRUE_, hasLeft: FALSE, hasRight: TRUE_, hasParent: FALSE, action: walker.RIGHT},
+		{key: 1, value: 1, level: 2, hasPrev: TRUE_, hasNext: FALSE, hasLeft: FALSE, hasRight: FALSE, hasParent: TRUE_, action: walker.RETURN},
+	}
+	w := walker.New((f_walk)(func(f walker.F) {
+		assertWalk(f, nodes, t)
+	}))
+	key, value, found, err := w.MaxE()
+	if key != 1 || value != 1 || found != true || err != nil {
+		t.Fatalf("MaxE() returned (%v, %v, %v, %v)", key, value, found, err)
+	}
+}
+
+// Test_LowerBoundE
+func Test_LowerBoundE(t *testing.T) {
+	nodes := []node{
+		{key: 0, value: 0, level: 1, hasPrev: FALSE, hasNext: TRUE_, hasLeft: FALSE, hasRight: TRUE_, hasParent: FALSE, action: walker.RIGHT},
+		{key: 3, value: 3, level: 2, hasPrev: TRUE_, hasNext: FALSE, hasLeft: FALSE, hasRight: FALSE, hasParent: TRUE_, action: walker.RETURN},
+	}
+	w := walker.New((f_walk)(func(f walker.F) {
+		assertWalk(f, nodes, t)
+	}))
+	key, value, found, err := w.LowerBoundE(2)
+	if key != 0 || value != 0 || found != true || err != nil {
+		t.Fatalf("LowerBoundE() returned (%v, %v, %v, %v)", key, value, found, err)
+	}
+	nodes = []node{
+		{key: 0, value: 0, level: 1, hasPrev: FALSE, hasNext: TRUE_, hasLeft: FALSE, hasRight: TRUE_, hasParent: FALSE, action: walker.RETURN},
+	}
+	_, _, found, err = w.LowerBoundE("2")
+	if found != false {
+		t.Fatalf("LowerBoundE() returned found after a panic")
+	}
+	assertPanicError(err, t)
+}
+
+// Test_UpperBoundE
+func Test_UpperBoundE(t *testing.T) {
+	nodes := []node{
+		{key: 3, value: 3, level: 1, hasPrev: TRUE_, hasNext: FALSE, hasLeft: TRUE_, hasRight: FALSE, hasParent: FALSE, action: walker.LEFT},
+		{key: 0, value: 0, level: 2, hasPrev: FALSE, hasNext: TRUE_, hasLeft: FALSE, hasRight: FALSE, hasParent: TRUE_, action: walker.RETURN},
+	}
+	w := walker.New((f_walk)(func(f walker.F) {
+		assertWalk(f, nodes, t)
+	}))
+	key, value, found, err := w.UpperBoundE(2)
+	if key != 3 || value != 3 || found != true || err != nil {
+		t.Fatalf("UpperBoundE() returned (%v, %v, %v, %v)", key, value, found, err)
+	}
+}
+
+// Test_ForeachRange
+func Test_ForeachRange(t *testing.T) {
+	w := newWalker(7, t)
+	for _, test := range rangeTests {
+		name := fmt.Sprintf("ForeachRange(%d, %d, %v, %v)", test.lo, test.hi, test.incLo, test.incHi)
+		keys := []int{}
+		w.ForeachRange(test.lo, test.hi, test.incLo, test.incHi, collect(&keys, t))
+		assertKeys(name, keys, test.keys, t)
+	}
+}
+
+// Test_ForeachRangeReverse
+func Test_ForeachRangeReverse(t *testing.T) {
+	w := newWalker(7, t)
+	for _, test := range rangeTests {
+		name := fmt.Sprintf("ForeachRangeReverse(%d, %d, %v, %v)", test.lo, test.hi, test.incLo, test.incHi)
+		keys := []int{}
+		w.ForeachRangeReverse(test.lo, test.hi, test.incLo, test.incHi, collect(&keys, t))
+		assertKeys(name, keys, reverse(test.keys), t)
+	}
+}
+
+// Test_ForeachRange_Sizes runs every range over trees of several sizes and shapes
+func Test_ForeachRange_Sizes(t *testing.T) {
+	for n := 0; n <= 16; n++ {
+		w := newWalker(n, t)
+		for lo := -1; lo <= n; lo++ {
+			for hi := -1; hi <= n; hi++ {
+				for _, incLo := range []bool{TRUE_, FALSE} {
+					for _, incHi := range []bool{TRUE_, FALSE} {
+						expected := []int{}
+						for k := 0; k < n; k++ {
+							if (k > lo || (incLo && k == lo)) && (k < hi || (incHi && k == hi)) {
+								expected = append(expected, k)
+							}
+						}
+						name := fmt.Sprintf("n=%d, ForeachRange(%d, %d, %v, %v)", n, lo, hi, incLo, incHi)
+						keys := []int{}
+						w.ForeachRange(lo, hi, incLo, incHi, collect(&keys, t))
+						assertKeys(name, keys, expected, t)
+						keys = []int{}
+						w.ForeachRangeReverse(lo, hi, incLo, incHi, collect(&keys, t))
+						assertKeys("Reverse "+name, keys, reverse(expected), t)
+					}
+				}
+			}
+		}
+	}
+}
+
+// Test_ForeachRange_Empty
+func Test_ForeachRange_Empty(t *testing.T) {
+	w := newWalker(0, t)
+	w.ForeachRange(0, 10, TRUE_, TRUE_, func(key interface{}, _ interface{}) {
+		t.Fatalf("ForeachRange() visited '%v' in an empty tree", key)
+	})
+	w.ForeachRangeReverse(0, 10, TRUE_, TRUE_, func(key interface{}, _ interface{}) {
+		t.Fatalf("ForeachRangeReverse() visited '%v' in an empty tree", key)
+	})
+}
+
+// until returns an F_VisitWhile that appends each key to keys,
+// stopping after count keys
+func until(count int, keys *[]int) walker.F_VisitWhile {
+	return func(key interface{}, _ interface{}) bool {
+		*keys = append(*keys, key.(int))
+		return len(*keys) < count
+	}
+}
+
+// Test_ForeachWhile_Stop confirms that the While variants stop as soon as f returns false
+func Test_ForeachWhile_Stop(t *testing.T) {
+	w := newWalker(7, t)
+	keys := []int{}
+	w.ForeachMinWhile(until(3, &keys))
+	assertKeys("ForeachMinWhile()", keys, []int{0, 1, 2}, t)
+	keys = []int{}
+	w.ForeachMaxWhile(until(3, &keys))
+	assertKeys("ForeachMaxWhile()", keys, []int{6, 5, 4}, t)
+	keys = []int{}
+	w.ForeachRangeWhile(1, 5, FALSE, TRUE_, until(2, &keys))
+	assertKeys("ForeachRangeWhile()", keys, []int{2, 3}, t)
+	keys = []int{}
+	w.ForeachRangeReverseWhile(1, 5, TRUE_, FALSE, until(2, &keys))
+	assertKeys("ForeachRangeReverseWhile()", keys, []int{4, 3}, t)
+	// Stopping on the first key
+	keys = []int{}
+	w.ForeachMinWhile(until(1, &keys))
+	assertKeys("ForeachMinWhile()", keys, []int{0}, t)
+	keys = []int{}
+	w.ForeachRangeReverseWhile(1, 5, TRUE_, TRUE_, until(1, &keys))
+	assertKeys("ForeachRangeReverseWhile()", keys, []int{5}, t)
+}
+
+// Test_ForeachWhile_All confirms that the While variants visit every key while f returns true
+func Test_ForeachWhile_All(t *testing.T) {
+	w := newWalker(7, t)
+	keys := []int{}
+	w.ForeachMinWhile(until(100, &keys))
+	assertKeys("ForeachMinWhile()", keys, []int{0, 1, 2, 3, 4, 5, 6}, t)
+	keys = []int{}
+	w.ForeachMaxWhile(until(100, &keys))
+	assertKeys("ForeachMaxWhile()", keys, []int{6, 5, 4, 3, 2, 1, 0}, t)
+	for _, test := range rangeTests {
+		name := fmt.Sprintf("ForeachRangeWhile(%d, %d, %v, %v)", test.lo, test.hi, test.incLo, test.incHi)
+		keys = []int{}
+		w.ForeachRangeWhile(test.lo, test.hi, test.incLo, test.incHi, until(100, &keys))
+		assertKeys(name, keys, test.keys, t)
+		keys = []int{}
+		w.ForeachRangeReverseWhile(test.lo, test.hi, test.incLo, test.incHi, until(100, &keys))
+		assertKeys("Reverse "+name, keys, reverse(test.keys), t)
+	}
+}
+
+// Test_ForeachWhile_Empty
+func Test_ForeachWhile_Empty(t *testing.T) {
+	w := newWalker(0, t)
+	keys := []int{}
+	w.ForeachMinWhile(until(100, &keys))
+	w.ForeachMaxWhile(until(100, &keys))
+	w.ForeachRangeWhile(0, 10, TRUE_, TRUE_, until(100, &keys))
+	w.ForeachRangeReverseWhile(0, 10, TRUE_, TRUE_, until(100, &keys))
+	assertKeys("ForeachXxxWhile()", keys, []int{}, t)
+}
+
+// Test_ForeachContext confirms that the Context variants visit the same keys as ForeachXxx
+func Test_ForeachContext(t *testing.T) {
+	ctx := context.Background()
+	w := newWalker(7, t)
+	keys := []int{}
+	err := w.ForeachMinContext(ctx, collect(&keys, t))
+	assertKeys("ForeachMinContext()", keys, []int{0, 1, 2, 3, 4, 5, 6}, t)
+	if err != nil {
+		t.Fatalf("ForeachMinContext() returned '%v'", err)
+	}
+	keys = []int{}
+	err = w.ForeachMaxContext(ctx, collect(&keys, t))
+	assertKeys("ForeachMaxContext()", keys, []int{6, 5, 4, 3, 2, 1, 0}, t)
+	if err != nil {
+		t.Fatalf("ForeachMaxContext() returned '%v'", err)
+	}
+	for _, test := range rangeTests {
+		name := fmt.Sprintf("ForeachRangeContext(%d, %d, %v, %v)", test.lo, test.hi, test.incLo, test.incHi)
+		keys = []int{}
+		if err := w.ForeachRangeContext(ctx, test.lo, test.hi, test.incLo, test.incHi, collect(&keys, t)); err != nil {
+			t.Fatalf("%s returned '%v'", name, err)
+		}
+		assertKeys(name, keys, test.keys, t)
+		keys = []int{}
+		if err := w.ForeachRangeReverseContext(ctx, test.lo, test.hi, test.incLo, test.incHi, collect(&keys, t)); err != nil {
+			t.Fatalf("Reverse %s returned '%v'", name, err)
+		}
+		assertKeys("Reverse "+name, keys, reverse(test.keys), t)
+	}
+}
+
+// Test_ForeachContext_Cancel confirms that cancelling the context in the middle
+// of a walk stops it before the next node, reporting ctx.Err()
+func Test_ForeachContext_Cancel(t *testing.T) {
+	w := newWalker(7, t)
+	for name, foreach := range map[string]func(context.Context, walker.F_Visit) error{
+		"ForeachMinContext()": w.ForeachMinContext,
+		"ForeachMaxContext()": w.ForeachMaxContext,
+		"ForeachRangeContext()": func(ctx context.Context, f walker.F_Visit) error {
+			return w.ForeachRangeContext(ctx, 1, 5, TRUE_, TRUE_, f)
+		},
+		"ForeachRangeReverseContext()": func(ctx context.Context, f walker.F_Visit) error {
+			return w.ForeachRangeReverseContext(ctx, 1, 5, TRUE_, TRUE_, f)
+		},
+	} {
+		ctx, cancel := context.WithCancel(context.Background())
+		count := 0
+		err := foreach(ctx, func(_ interface{}, _ interface{}) {
+			if count++; count == 3 {
+				cancel()
+			}
+		})
+		if count != 3 || err != context.Canceled {
+			t.Fatalf("%s visited %d keys and returned '%v' instead of 3 and '%v'", name, count, err, context.Canceled)
+		}
+	}
+}
+
+// Test_WalkContext_Cancelled confirms that a walk with a context that has
+// already been cancelled does not call f
+func Test_WalkContext_Cancelled(t *testing.T) {
+	w := newWalker(7, t)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := w.WalkContext(ctx, func(n walker.Node) walker.Action {
+		t.Fatalf("WalkContext() visited '%v' with a cancelled context", n.Key())
+		return walker.RETURN
+	})
+	if err != context.Canceled {
+		t.Fatalf("WalkContext() returned '%v' instead of '%v'", err, context.Canceled)
+	}
+	keys := []int{}
+	err = w.ForeachMinContext(ctx, collect(&keys, t))
+	assertKeys("ForeachMinContext()", keys, []int{}, t)
+	if err != context.Canceled {
+		t.Fatalf("ForeachMinContext() returned '%v' instead of '%v'", err, context.Canceled)
+	}
+}
+
+// Test_WalkContext_Error confirms that WalkContext reports an illegal action
+func Test_WalkContext_Error(t *testing.T) {
+	w := newWalker(1, t)
+	err := w.WalkContext(context.Background(), func(walker.Node) walker.Action {
+		return walker.LEFT
+	})
+	assertActionError(err, bst.ErrNoSuchNeighbor, "walk: no such neighbor 'LEFT'", t)
+}
+
+// Test_Seq
+func Test_Seq(t *testing.T) {
+	w := newWalker(7, t)
+	keys := []int{}
+	for key, value := range w.All() {
+		collect(&keys, t)(key, value)
+	}
+	assertKeys("All()", keys, []int{0, 1, 2, 3, 4, 5, 6}, t)
+	keys = []int{}
+	for key, value := range w.Backward() {
+		collect(&keys, t)(key, value)
+	}
+	assertKeys("Backward()", keys, []int{6, 5, 4, 3, 2, 1, 0}, t)
+	for _, test := range rangeTests {
+		name := fmt.Sprintf("Range(%d, %d, %v, %v)", test.lo, test.hi, test.incLo, test.incHi)
+		keys = []int{}
+		for key, value := range w.Range(test.lo, test.hi, test.incLo, test.incHi) {
+			collect(&keys, t)(key, value)
+		}
+		assertKeys(name, keys, test.keys, t)
+		keys = []int{}
+		for key, value := range w.RangeBackward(test.lo, test.hi, test.incLo, test.incHi) {
+			collect(&keys, t)(key, value)
+		}
+		assertKeys("Backward "+name, keys, reverse(test.keys), t)
+	}
+}
+
+// Test_Seq_Break confirms that breaking out of a range loop stops the walk
+func Test_Seq_Break(t *testing.T) {
+	w := newWalker(7, t)
+	keys := []int{}
+	for key := range w.All() {
+		if keys = append(keys, key.(int)); len(keys) == 2 {
+			break
+		}
+	}
+	assertKeys("All()", keys, []int{0, 1}, t)
+	keys = []int{}
+	for key := range w.Backward() {
+		if keys = append(keys, key.(int)); len(keys) == 2 {
+			break
+		}
+	}
+	assertKeys("Backward()", keys, []int{6, 5}, t)
+	keys = []int{}
+	for key := range w.Range(1, 5, FALSE, FALSE) {
+		if keys = append(keys, key.(int)); len(keys) == 2 {
+			break
+		}
+	}
+	assertKeys("Range()", keys, []int{2, 3}, t)
+	keys = []int{}
+	for key := range w.RangeBackward(1, 5, FALSE, FALSE) {
+		if keys = append(keys, key.(int)); len(keys) == 2 {
+			break
+		}
+	}
+	assertKeys("RangeBackward()", keys, []int{4, 3}, t)
+}
+
+// Test_Seq_Empty
+func Test_Seq_Empty(t *testing.T) {
+	w := newWalker(0, t)
+	for key := range w.All() {
+		t.Fatalf("All() returned '%v' for an empty tree", key)
+	}
+	for key := range w.Backward() {
+		t.Fatalf("Backward() returned '%v' for an empty tree", key)
+	}
+	for key := range w.Range(0, 10, TRUE_, TRUE_) {
+		t.Fatalf("Range() returned '%v' for an empty tree", key)
+	}
+	for key := range w.RangeBackward(0, 10, TRUE_, TRUE_) {
+		t.Fatalf("RangeBackward() returned '%v' for an empty tree", key)
+	}
+}